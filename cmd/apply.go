@@ -0,0 +1,67 @@
+/*
+Copyright © 2021 Nick Hackman <snickhackman@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package cmd
+
+import (
+	"time"
+
+	"github.com/NickHackman/releaser/internal/plan"
+	"github.com/NickHackman/releaser/internal/tui"
+	"github.com/spf13/cobra"
+)
+
+// applyCmd represents the apply command
+var applyCmd = &cobra.Command{
+	Use:   "apply <file>",
+	Short: "Create the releases of a plan file",
+	Long: `Create the releases of a plan file written by releaser plan.
+
+Refuses to create any release if a branch has moved past its planned SHA
+or a planned tag already exists or is drafted.
+
+Examples:
+
+releaser apply releases.yaml
+
+releaser apply releases.yaml --dry-run`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		p, err := plan.Read(args[0])
+		cobra.CheckErr(err)
+
+		config := initConfig(cmd)
+		gh := newClient(config)
+
+		cobra.CheckErr(
+			tui.Apply(gh, config, p),
+		)
+	},
+}
+
+func init() {
+	applyCmd.Flags().String("token", "", "GitHub Oauth Token")
+	applyCmd.Flags().DurationP("timeout", "t", time.Minute, "Timeout duration to wait for GitHub to respond before exiting")
+	applyCmd.Flags().Bool("atomic", false, "Roll back every release when any release fails")
+	applyCmd.Flags().Bool("dry-run", false, "Verify and print the plan without creating releases")
+	applyCmd.Flags().String("ci", "off", "What happens when CI of the commit to release is failing or pending (off, warn, refuse)")
+	rootCmd.AddCommand(applyCmd)
+}
@@ -0,0 +1,61 @@
+/*
+Copyright © 2021 Nick Hackman <snickhackman@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package cmd
+
+import (
+	"github.com/NickHackman/releaser/internal/tui"
+	"github.com/spf13/cobra"
+)
+
+// planCmd represents the plan command
+var planCmd = &cobra.Command{
+	Use:   "plan <file>",
+	Short: "Write releases to a plan file to review and apply later",
+	Long: `Select releases the same way as releaser, but instead of creating them
+write them to a plan file (YAML, or JSON when the file ends with .json).
+
+The plan includes the baseline tag, target SHA, commits and body of every release
+so it can be reviewed, for example in a pull request, and created with releaser apply.
+
+Examples:
+
+releaser plan releases.yaml
+
+releaser plan releases.json --org example --repositories example1,example2`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		config := initConfig(cmd)
+		gh := newClient(config)
+
+		config.DryRun = true
+		config.PlanFile = args[0]
+
+		cobra.CheckErr(
+			tui.Execute(gh, config),
+		)
+	},
+}
+
+func init() {
+	addReleaseFlags(planCmd)
+	rootCmd.AddCommand(planCmd)
+}
@@ -0,0 +1,66 @@
+/*
+Copyright © 2021 Nick Hackman <snickhackman@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package cmd
+
+import (
+	"time"
+
+	"github.com/NickHackman/releaser/internal/tui"
+	"github.com/spf13/cobra"
+)
+
+// publishDraftsCmd represents the publish-drafts command
+var publishDraftsCmd = &cobra.Command{
+	Use:   "publish-drafts",
+	Short: "Publish draft releases of an organization",
+	Long: `List the draft releases of every repository in an organization,
+created with releaser --draft, and publish the selected ones.
+
+Examples:
+
+releaser publish-drafts --org example
+
+Bypass the UI entirely and publish the drafts of repositories:
+
+releaser publish-drafts --org example --repositories example1,example2
+
+Print the drafts that would be published without publishing them:
+
+releaser publish-drafts --org example --repositories example1,example2 --dry-run`,
+	Run: func(cmd *cobra.Command, args []string) {
+		config := initConfig(cmd)
+		gh := newClient(config)
+
+		cobra.CheckErr(
+			tui.PublishDrafts(gh, config),
+		)
+	},
+}
+
+func init() {
+	publishDraftsCmd.Flags().String("token", "", "GitHub Oauth Token")
+	publishDraftsCmd.Flags().StringP("org", "o", "", "GitHub organization to publish drafts for, defaults to the authenticated user")
+	publishDraftsCmd.Flags().DurationP("timeout", "t", time.Minute, "Timeout duration to wait for GitHub to respond before exiting")
+	publishDraftsCmd.Flags().StringSlice("repositories", []string{}, "Repositories to publish drafts for, bypasses the UI")
+	publishDraftsCmd.Flags().Bool("dry-run", false, "Print the drafts that would be published without publishing them")
+	rootCmd.AddCommand(publishDraftsCmd)
+}
@@ -0,0 +1,62 @@
+/*
+Copyright © 2021 Nick Hackman <snickhackman@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package cmd
+
+import (
+	"time"
+
+	"github.com/NickHackman/releaser/internal/tui"
+	"github.com/spf13/cobra"
+)
+
+// rollbackCmd represents the rollback command
+var rollbackCmd = &cobra.Command{
+	Use:   "rollback <run-id>",
+	Short: "Undo the releases of a run",
+	Long: `Delete the releases, tags and release branches created by a run and
+revert the commits of version files, in reverse order.
+
+Every run that created something prints its run id. A commit of version files
+is only reverted while it is still the head of its branch.
+
+Examples:
+
+releaser rollback 20220131-120000.123
+
+releaser rollback 20220131-120000.123 --dry-run`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		config := initConfig(cmd)
+		gh := newClient(config)
+
+		cobra.CheckErr(
+			tui.Rollback(gh, config, args[0]),
+		)
+	},
+}
+
+func init() {
+	rollbackCmd.Flags().String("token", "", "GitHub Oauth Token")
+	rollbackCmd.Flags().DurationP("timeout", "t", time.Minute, "Timeout duration to wait for GitHub to respond before exiting")
+	rollbackCmd.Flags().Bool("dry-run", false, "Print what would be rolled back without rolling back")
+	rootCmd.AddCommand(rollbackCmd)
+}
@@ -53,6 +53,30 @@ Methods:
 major        Increment Major version 1.3.0 -> 2.0.0
 minor        Increment Minor version 1.3.0 -> 1.4.0
 patch        Increment Patch version 1.3.0 -> 1.3.1
+auto         Infer from Conventional Commits since the last tag
+             feat: -> minor, fix: -> patch, feat!: or BREAKING CHANGE: -> major
+prerelease:<identifier>
+             Start or continue a pre-release line (alpha, beta, rc, etc)
+             prerelease:rc 1.4.0 -> 1.5.0-rc.1, 1.5.0-rc.1 -> 1.5.0-rc.2
+promote      Promote a pre-release to final 1.5.0-rc.3 -> 1.5.0, otherwise patch 1.5.0 -> 1.5.1
+
+Version Schemes:
+
+semver       Semantic Versioning https://semver.org (default)
+calver       Calendar Versioning https://calver.org using version.format
+
+Calendar Versioning Formats:
+
+YYYY.0M.MICRO    2022.01.0 -> 2022.01.1 -> 2022.02.0
+YYYY.MM.DD.N     2022.1.31.1 -> 2022.1.31.2 -> 2022.2.1.1
+
+Tokens YYYY, YY, 0Y, MM, 0M, WW, 0W, DD, 0D, MAJOR, MINOR, MICRO and N (counter starting at 1)
+
+Tags:
+
+The prefix and suffix of the latest tag are preserved 1.2.3 -> 1.3.0, release-1.2.3 -> release-1.3.0.
+Tags that are not versions (nightly) are ignored and new versions are greater than every existing tag.
+Provide version.tag_template to only consider matching tags e.g. for monorepos service-a/v{{ .Version }}.
 
 Template:
 
@@ -63,7 +87,15 @@ Top Level Variables:
 {{ .RepositoryURL }}                   https://github.com/octocat/hello-world	
 {{ .RepositoryDescription }}           Example description
 {{ .RepositoryDefaultBranch }}         main
-{{ .Commits }}                         List of commits
+{{ .Commits }}                         List of commits, excluding noise
+{{ .Noise }}                           List of commits discounted by the noise rules
+{{ .AheadBy }}                         Number of commits since the latest tag
+{{ .FilesChanged }}                    Number of files changed since the latest tag (at most 300)
+{{ .Additions }}                       Lines added since the latest tag
+{{ .Deletions }}                       Lines deleted since the latest tag
+{{ .GeneratedNotes }}                  Release notes generated by GitHub following .github/release.yml
+{{ .PullRequests }}                    List of merged pull requests (resolved only when used)
+{{ .Sections }}                        Pull requests grouped by the labels of changelog.sections, noise last under changelog.noise
 
 Commit:
 
@@ -71,6 +103,7 @@ Commit:
 {{ .URL }}                             URL to commit
 {{ .Summary }}                         First line of the commit message
 {{ .Message }}                         Full commit message (includes newlines)
+{{ .PR }}                              Merged pull request of the commit, empty when there is none
 
 Author/Committer:
 {{ .AuthorUsername }}                  octocat (GitHub Username)
@@ -79,6 +112,21 @@ Author/Committer:
 {{ .AuthorDate }} 
 {{ .AuthorURL }}                       https://github.com/octocat
 
+Pull Request:
+{{ .Number }}                          1347
+{{ .Title }}                           Title of the pull request
+{{ .URL }}                             https://github.com/octocat/hello-world/pull/1347
+{{ .Body }}                            Description of the pull request
+{{ .Labels }}                          List of label names
+{{ .MergedAt }}
+{{ .AuthorUsername }}                  octocat
+{{ .AuthorURL }}                       https://github.com/octocat
+
+Section:
+{{ .Title }}                           Features
+{{ .Entries }}                         Pull requests, and commits without one, each with
+                                      .Title .URL .Number (0 for commits) .Labels .AuthorUsername
+
 Templates also include Sprig functions: https://masterminds.github.io/sprig/strings.html
 
 Template Example:
@@ -87,6 +135,17 @@ Template Example:
 {{ substr 0 8 .Sha }} committed by {{ .CommitterUsername }} and authored by {{ .AuthorUsername }} {{ .Summary }}
 {{ end }}
 
+{{ range .PullRequests }}
+- {{ .Title }} (#{{ .Number }}) by @{{ .AuthorUsername }}
+{{ end }}
+
+{{ range .Sections }}
+## {{ .Title }}
+{{ range .Entries }}
+- {{ .Title }}{{ if .Number }} (#{{ .Number }}){{ end }}
+{{ end }}
+{{ end }}
+
 Examples:
 
 Log into github.com:
@@ -101,41 +160,81 @@ Specify how to determine the version:
 
 releaser --version.change minor
 
+Create release candidates:
+
+releaser --version.change prerelease:rc
+
+Calendar version all repositories:
+
+releaser --version.scheme calver --version.format YYYY.0M.MICRO
+
 Bypass the UI entirely and create releases:
 
 releaser --org example --repositories example1,example2,example3
-`,
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := config.InitViper(cfgFile, cmd); err != nil {
-			if CreatedConfigErr, ok := err.(config.CreatedConfigErr); ok {
-				fmt.Println(CreatedConfigErr.Error())
-				os.Exit(0)
-			} else {
-				cobra.CheckErr(err)
-			}
-		}
 
-		config, err := config.Load()
-		cobra.CheckErr(err)
+Print the releases that would be created without creating them:
 
-		if err := config.CheckAuth(); err != nil {
-			fmt.Printf("Error: %v\n", err)
-			os.Exit(1)
-		}
+releaser --org example --repositories example1,example2,example3 --dry-run
 
-		gh, err := github.New().Host(config.Host).Token(config.Token).Build()
-		cobra.CheckErr(err)
+Write the releases to a plan file to review before creating them:
 
-		// if token is provided fetch user's Username
-		if config.Username == "" {
-			ctx, cancel := context.WithTimeout(context.Background(), config.Timeout)
-			defer cancel()
+releaser plan releases.yaml
+releaser apply releases.yaml
 
-			user, err := gh.User(ctx)
-			cobra.CheckErr(err)
+Roll back every release when any release fails, and undo a run later:
 
-			config.Username = user.GetLogin()
-		}
+releaser --org example --repositories example1,example2,example3 --atomic
+releaser rollback 20220131-120000
+
+Release the repositories of several organizations and the authenticated user together:
+
+releaser --org example,shared-libraries,octocat
+
+Release the repositories of a team:
+
+releaser --team example/squad-a
+
+Discover repositories of large organizations with few GraphQL queries:
+
+releaser --org example --discovery graphql
+
+Ignore cached responses from GitHub, or clear them:
+
+releaser --no-cache
+releaser cache clear
+
+Limit concurrent calls to GitHub to avoid secondary rate limits:
+
+releaser --concurrency.read 5 --concurrency.write 1
+
+Create releases as drafts and publish them later:
+
+releaser --draft
+releaser publish-drafts --org example
+
+Only release repositories with the service topic, excluding forks and experiments:
+
+releaser --filters.topics service --filters.forks exclude --filters.exclude_names 'experiment-*'
+
+Use the release notes generated by GitHub following each repository's .github/release.yml:
+
+releaser --generated_notes
+
+Upload assets to releases along with their checksums:
+
+releaser --org example --repositories example1 --asset 'example1=dist/*.tar.gz' --checksums
+
+Don't release repositories with only bot commits or changes to CI and docs:
+
+releaser --noise.authors 'dependabot[bot]' --noise.paths '.github/**,docs/**'
+
+Never release a commit whose CI is failing or pending:
+
+releaser --ci refuse
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		config := initConfig(cmd)
+		gh := newClient(config)
 
 		cobra.CheckErr(
 			tui.Execute(gh, config),
@@ -143,6 +242,66 @@ releaser --org example --repositories example1,example2,example3
 	},
 }
 
+// initConfig initializes viper and loads the config, exits when the config didn't exist and an example was written.
+func initConfig(cmd *cobra.Command) *config.Config {
+	if err := config.InitViper(cfgFile, cmd); err != nil {
+		if CreatedConfigErr, ok := err.(config.CreatedConfigErr); ok {
+			fmt.Println(CreatedConfigErr.Error())
+			os.Exit(0)
+		} else {
+			cobra.CheckErr(err)
+		}
+	}
+
+	config, err := config.Load()
+	cobra.CheckErr(err)
+
+	if err := config.CheckAuth(); err != nil {
+		fmt.Printf("Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	return config
+}
+
+// newClient builds the GitHub client for the config and fetches the authenticated user's username when missing.
+func newClient(c *config.Config) *github.Client {
+	var cacheDir string
+	if !c.NoCache {
+		dir, err := config.CacheDir()
+		cobra.CheckErr(err)
+
+		cacheDir = dir
+	}
+
+	gh, err := github.New().
+		Host(c.Host).
+		Token(c.Token).
+		TagFilter(c.MatchesTag).
+		TagCompare(c.CompareTags).
+		RepositoryFilter(c.Filter.Matches).
+		Noise(&c.Noise).
+		Concurrency(c.ReadConcurrency, c.WriteConcurrency).
+		Discovery(c.Discovery).
+		FileStats(c.UsesFileStats()).
+		Cache(cacheDir).
+		Build()
+	cobra.CheckErr(err)
+
+	// if token is provided fetch user's Username
+	if c.Username == "" {
+		ctx, cancel := context.WithTimeout(context.Background(), c.Timeout)
+		defer cancel()
+
+		user, err := gh.User(ctx)
+		cobra.CheckErr(err)
+
+		c.Username = user.GetLogin()
+	}
+
+	return gh
+}
+
 // Execute adds all child commands to the root command and sets flags appropriately.
 // This is called by main.main(). It only needs to happen once to the rootCmd.
 func Execute() {
@@ -152,12 +311,43 @@ func Execute() {
 func init() {
 	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is $XDG_CONFIG_HOME/releaser/config.yaml)")
 	rootCmd.PersistentFlags().String("host", "github.com", "Hostname of GitHub or GitHub Enterprise")
-	rootCmd.Flags().String("token", "", "GitHub Oauth Token")
-	rootCmd.Flags().StringP("org", "o", "", "GitHub organization to create releases")
-	rootCmd.Flags().String("template", "", "Go template that is the default message for all releases")
-	rootCmd.Flags().DurationP("timeout", "t", time.Minute, "Timeout duration to wait for GitHub to respond before exiting")
-	rootCmd.Flags().StringP("branch", "b", "", "Branch to create releases on (defaults to Repository's default branch)")
-	rootCmd.Flags().String("version.change", "", "Method to determine the new version based off the previous (major, minor, patch)")
-	rootCmd.Flags().StringSlice("repositories", make([]string, 0), "Repositories to release (if this flag is provided then noninteractive UI)")
-	rootCmd.Flags().Bool("create_release_branch", false, "Create a release branch for all releases")
+	rootCmd.PersistentFlags().Bool("no-cache", false, "Don't cache responses from GitHub")
+	rootCmd.PersistentFlags().Int("concurrency.read", 10, "Maximum repositories read from GitHub concurrently")
+	rootCmd.PersistentFlags().Int("concurrency.write", 3, "Maximum repositories written to GitHub concurrently")
+	addReleaseFlags(rootCmd)
+	rootCmd.Flags().Bool("dry-run", false, "Print the releases that would be created without creating them")
+}
+
+// addReleaseFlags adds the flags that determine which releases are created and how.
+func addReleaseFlags(cmd *cobra.Command) {
+	cmd.Flags().String("token", "", "GitHub Oauth Token")
+	cmd.Flags().StringSliceP("org", "o", make([]string, 0), "GitHub organizations to create releases, including the authenticated user")
+	cmd.Flags().String("team", "", "Only release the repositories of a GitHub team as org/team-slug")
+	cmd.Flags().String("template", "", "Go template that is the default message for all releases")
+	cmd.Flags().DurationP("timeout", "t", time.Minute, "Timeout duration to wait for GitHub to respond before exiting")
+	cmd.Flags().StringP("branch", "b", "", "Branch to create releases on (defaults to Repository's default branch)")
+	cmd.Flags().String("version.change", "", "Method to determine the new version based off the previous (major, minor, patch, auto, promote, prerelease:<identifier>)")
+	cmd.Flags().String("version.scheme", "", "Versioning scheme (semver, calver)")
+	cmd.Flags().String("version.format", "", "Calendar versioning format used by the calver scheme (default YYYY.0M.MICRO)")
+	cmd.Flags().String("version.tag_template", "", "Go template for tags where {{ .Version }} is the version, only matching tags are considered (defaults to the format of the latest tag)")
+	cmd.Flags().StringSlice("repositories", make([]string, 0), "Repositories to release (if this flag is provided then noninteractive UI)")
+	cmd.Flags().Bool("create_release_branch", false, "Create a release branch for all releases")
+	cmd.Flags().String("discovery", "rest", "How releasable repositories are discovered (rest, graphql)")
+	cmd.Flags().Bool("atomic", false, "Roll back every release when any release fails")
+	cmd.Flags().Bool("draft", false, "Create releases as drafts to publish later with releaser publish-drafts")
+	cmd.Flags().StringArray("asset", make([]string, 0), "Upload files matching a glob to the release of a repository as repo=path (repeatable)")
+	cmd.Flags().StringSlice("filters.topics", make([]string, 0), "Only release repositories with one of these topics")
+	cmd.Flags().StringSlice("filters.exclude_topics", make([]string, 0), "Don't release repositories with any of these topics")
+	cmd.Flags().StringSlice("filters.languages", make([]string, 0), "Only release repositories with one of these primary languages")
+	cmd.Flags().StringSlice("filters.exclude_languages", make([]string, 0), "Don't release repositories with any of these primary languages")
+	cmd.Flags().StringSlice("filters.visibility", make([]string, 0), "Only release repositories with one of these visibilities (public, private, internal)")
+	cmd.Flags().String("filters.forks", "", "Whether forks are released (include, exclude, only)")
+	cmd.Flags().StringSlice("filters.names", make([]string, 0), "Only release repositories with a name matching a glob or /regex/")
+	cmd.Flags().StringSlice("filters.exclude_names", make([]string, 0), "Don't release repositories with a name matching a glob or /regex/")
+	cmd.Flags().StringSlice("noise.authors", make([]string, 0), "Commits by these authors (logins, names or /regex/) don't make repositories releasable")
+	cmd.Flags().StringArray("noise.messages", make([]string, 0), "Commits with a message matching this regex don't make repositories releasable (repeatable)")
+	cmd.Flags().StringSlice("noise.paths", make([]string, 0), "Commits only changing paths matching these globs don't make repositories releasable (e.g. '.github/**')")
+	cmd.Flags().Bool("generated_notes", false, "Use the release notes generated by GitHub as the body instead of the template")
+	cmd.Flags().Bool("checksums", false, "Upload checksums.txt with the SHA-256 checksum of every asset")
+	cmd.Flags().String("ci", "off", "What happens when CI of the commit to release is failing or pending (off, warn, refuse)")
 }
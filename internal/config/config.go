@@ -7,6 +7,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/NickHackman/releaser/internal/github"
@@ -25,10 +26,44 @@ const (
 	TimeoutFlag             = "timeout"
 	BranchFlag              = "branch"
 	VersionChangeFlag       = "version.change"
+	VersionSchemeFlag       = "version.scheme"
+	VersionFormatFlag       = "version.format"
+	TagTemplateFlag         = "version.tag_template"
 	TokenFlag               = "token"
 	HostFlag                = "host"
 	RepositoriesFlag        = "repositories"
 	CreateReleaseBranchFlag = "create_release_branch"
+	DryRunFlag              = "dry-run"
+	DraftFlag               = "draft"
+	AtomicFlag              = "atomic"
+	ReadConcurrencyFlag     = "concurrency.read"
+	WriteConcurrencyFlag    = "concurrency.write"
+	DiscoveryFlag           = "discovery"
+	NoCacheFlag             = "no-cache"
+	AssetFlag               = "asset"
+	ChecksumsFlag           = "checksums"
+	GeneratedNotesFlag      = "generated_notes"
+	TeamFlag                = "team"
+	CIFlag                  = "ci"
+
+	FilterTopicsFlag           = "filters.topics"
+	FilterExcludeTopicsFlag    = "filters.exclude_topics"
+	FilterLanguagesFlag        = "filters.languages"
+	FilterExcludeLanguagesFlag = "filters.exclude_languages"
+	FilterVisibilityFlag       = "filters.visibility"
+	FilterForksFlag            = "filters.forks"
+	FilterNamesFlag            = "filters.names"
+	FilterExcludeNamesFlag     = "filters.exclude_names"
+
+	NoiseAuthorsFlag  = "noise.authors"
+	NoiseMessagesFlag = "noise.messages"
+	NoisePathsFlag    = "noise.paths"
+
+	overridesKey      = "overrides"
+	changelogKey      = "changelog"
+	changelogOtherKey = "changelog.other"
+
+	defaultChangelogOther = "Other Changes"
 )
 
 // CreatedConfigErr error returned when InitViper fails due to the config not existing
@@ -47,15 +82,42 @@ var flags = []string{
 	TimeoutFlag,
 	BranchFlag,
 	VersionChangeFlag,
+	VersionSchemeFlag,
+	VersionFormatFlag,
+	TagTemplateFlag,
 	TokenFlag,
 	HostFlag,
 	RepositoriesFlag,
 	CreateReleaseBranchFlag,
+	DryRunFlag,
+	DraftFlag,
+	AtomicFlag,
+	ReadConcurrencyFlag,
+	WriteConcurrencyFlag,
+	DiscoveryFlag,
+	NoCacheFlag,
+	AssetFlag,
+	ChecksumsFlag,
+	GeneratedNotesFlag,
+	TeamFlag,
+	CIFlag,
+	FilterTopicsFlag,
+	FilterExcludeTopicsFlag,
+	FilterLanguagesFlag,
+	FilterExcludeLanguagesFlag,
+	FilterVisibilityFlag,
+	FilterForksFlag,
+	FilterNamesFlag,
+	FilterExcludeNamesFlag,
+	NoiseAuthorsFlag,
+	NoiseMessagesFlag,
+	NoisePathsFlag,
 }
 
 const (
 	configFilename = "config.yaml"
 	hostsFilename  = "hosts.yaml"
+	runsDirname    = "runs"
 )
 
 // TerminalConfig configuration maintained for the terminal
@@ -63,6 +125,10 @@ type TerminalConfig struct {
 	Width    int
 	Height   int
 	Releases chan<- []*github.RepositoryReleaseResponse
+	// Plan releases that would have been created in a dry run.
+	Plan chan<- []*github.RepositoryRelease
+	// Journal everything created by the releases.
+	Journal chan<- *github.Journal
 }
 
 // AuthHosts format for how auth is stored inside of hosts.yaml.
@@ -77,17 +143,120 @@ type Auth struct {
 	Token    string
 }
 
+// Override configuration for a single repository that takes precedence over the global configuration.
+//
+// overrides:
+//   service-a:
+//     version:
+//       scheme: calver
+//       format: YYYY.0M.MICRO
+//       tag_template: service-a/{{ .Version }}
+//     files:
+//       - path: package.json
+//         key: version
+//     assets:
+//       - dist/*.tar.gz
+type Override struct {
+	Version VersionOverride
+	Files   []github.VersionFile
+	// Assets glob patterns of files uploaded to every release.
+	Assets []string
+}
+
+type VersionOverride struct {
+	Scheme      string
+	Format      string
+	TagTemplate string `mapstructure:"tag_template"`
+}
+
+// Changelog groups pull requests into sections by their labels.
+//
+// changelog:
+//   sections:
+//     - title: Features
+//       labels: [enhancement]
+//     - title: Fixes
+//       labels: [bug]
+//   exclude: [skip-changelog]
+//   other: Other Changes
+//   noise: Maintenance
+type Changelog struct {
+	// Sections in the order they're rendered, a pull request belongs to the first section with one of its labels.
+	Sections []ChangelogSection
+	// Exclude pull requests with any of these labels.
+	Exclude []string
+	// Other title of the section of pull requests and commits without a label of any section, omitted when empty.
+	Other string
+	// Noise title of the section of commits discounted as noise, omitted when empty.
+	Noise string
+}
+
+type ChangelogSection struct {
+	Title  string
+	Labels []string
+}
+
+// Section the title of the section of a pull request with labels, false when it's excluded from the changelog.
+func (c Changelog) Section(labels []string) (string, bool) {
+	for _, label := range labels {
+		if containsFold(c.Exclude, label) {
+			return "", false
+		}
+	}
+
+	for _, section := range c.Sections {
+		for _, label := range labels {
+			if containsFold(section.Labels, label) {
+				return section.Title, true
+			}
+		}
+	}
+
+	return c.Other, c.Other != ""
+}
+
+func containsFold(values []string, value string) bool {
+	for _, current := range values {
+		if strings.EqualFold(current, value) {
+			return true
+		}
+	}
+
+	return false
+}
+
 type Config struct {
 	Username            string
 	Host                string
 	Org                 string
+	Orgs                []string
+	Team                string
 	Branch              string
 	Token               string
 	Template            string
 	CreateReleaseBranch bool
+	DryRun              bool
+	Draft               bool
+	Atomic              bool
+	ReadConcurrency     int
+	WriteConcurrency    int
+	Discovery           github.Discovery
+	CI                  github.CIGate
+	NoCache             bool
+	Checksums           bool
+	GeneratedNotes      bool
+	PlanFile            string
 	Repositories        []string
 	Timeout             time.Duration
 	VersionChange       version.Change
+	VersionScheme       version.Scheme
+	TagTemplate         string
+	Overrides           map[string]Override
+	Changelog           Changelog
+	Filter              github.RepositoryFilter
+	Noise               github.NoiseFilter
+	// Assets glob patterns of files uploaded to releases by repository, provided as --asset repo=path.
+	Assets map[string][]string
 
 	AuthHosts AuthHosts
 	Terminal  *TerminalConfig
@@ -111,6 +280,26 @@ func configDir() (string, error) {
 	return filepath.Join(config, "releaser"), nil
 }
 
+// CacheDir directory GitHub responses are cached in.
+func CacheDir() (string, error) {
+	cache, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(cache, "releaser"), nil
+}
+
+// RunsDir directory the journals of runs are stored in.
+func RunsDir() (string, error) {
+	dir, err := configDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, runsDirname), nil
+}
+
 func InitViper(filename string, cmd *cobra.Command) error {
 	for _, flag := range flags {
 		if exists := cmd.Flags().Lookup(flag); exists == nil {
@@ -190,7 +379,192 @@ func (c *Config) Refresh() error {
 
 	c.VersionChange = change
 
-	return nil
+	c.TagTemplate = viper.GetString(TagTemplateFlag)
+	c.VersionScheme, c.Overrides, err = loadVersioning()
+	if err != nil {
+		return err
+	}
+
+	c.Changelog, err = loadChangelog()
+	if err != nil {
+		return err
+	}
+
+	c.Filter, err = loadFilter()
+	if err != nil {
+		return err
+	}
+
+	c.Noise, err = loadNoise()
+	return err
+}
+
+// loadFilter loads and validates the filter of repositories considered for releases.
+func loadFilter() (github.RepositoryFilter, error) {
+	filter := github.RepositoryFilter{
+		Topics:           viper.GetStringSlice(FilterTopicsFlag),
+		ExcludeTopics:    viper.GetStringSlice(FilterExcludeTopicsFlag),
+		Languages:        viper.GetStringSlice(FilterLanguagesFlag),
+		ExcludeLanguages: viper.GetStringSlice(FilterExcludeLanguagesFlag),
+		Visibility:       viper.GetStringSlice(FilterVisibilityFlag),
+		Forks:            github.Forks(viper.GetString(FilterForksFlag)),
+		Names:            viper.GetStringSlice(FilterNamesFlag),
+		ExcludeNames:     viper.GetStringSlice(FilterExcludeNamesFlag),
+	}
+
+	if err := filter.Validate(); err != nil {
+		return github.RepositoryFilter{}, fmt.Errorf("invalid filters: %v", err)
+	}
+
+	return filter, nil
+}
+
+func loadNoise() (github.NoiseFilter, error) {
+	noise := github.NoiseFilter{
+		Authors:  viper.GetStringSlice(NoiseAuthorsFlag),
+		Messages: viper.GetStringSlice(NoiseMessagesFlag),
+		Paths:    viper.GetStringSlice(NoisePathsFlag),
+	}
+
+	if err := noise.Validate(); err != nil {
+		return github.NoiseFilter{}, fmt.Errorf("invalid noise: %v", err)
+	}
+
+	return noise, nil
+}
+
+// loadChangelog loads the changelog sections, pull requests without a section are in defaultChangelogOther unless
+// configured otherwise.
+func loadChangelog() (Changelog, error) {
+	var changelog Changelog
+	if err := viper.UnmarshalKey(changelogKey, &changelog); err != nil {
+		return Changelog{}, fmt.Errorf("failed to parse %s: %v", changelogKey, err)
+	}
+
+	if !viper.IsSet(changelogOtherKey) {
+		changelog.Other = defaultChangelogOther
+	}
+
+	for _, section := range changelog.Sections {
+		if section.Title == "" {
+			return Changelog{}, fmt.Errorf("invalid %s: section with labels %v is missing a title", changelogKey, section.Labels)
+		}
+	}
+
+	return changelog, nil
+}
+
+// loadVersioning loads the global version scheme and the overrides, validating the version scheme, tag template and
+// version files of every override.
+func loadVersioning() (version.Scheme, map[string]Override, error) {
+	scheme, err := version.SchemeFromString(viper.GetString(VersionSchemeFlag), viper.GetString(VersionFormatFlag))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if tagTemplate := viper.GetString(TagTemplateFlag); tagTemplate != "" {
+		if _, err := version.TagFormatFromTemplate(tagTemplate); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	overrides := make(map[string]Override)
+	if err := viper.UnmarshalKey(overridesKey, &overrides); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse %s: %v", overridesKey, err)
+	}
+
+	for name, override := range overrides {
+		if override.Version.Scheme != "" {
+			if _, err := version.SchemeFromString(override.Version.Scheme, override.Version.Format); err != nil {
+				return nil, nil, fmt.Errorf("invalid %s for repository %s: %v", overridesKey, name, err)
+			}
+		}
+
+		if override.Version.TagTemplate != "" {
+			if _, err := version.TagFormatFromTemplate(override.Version.TagTemplate); err != nil {
+				return nil, nil, fmt.Errorf("invalid %s for repository %s: %v", overridesKey, name, err)
+			}
+		}
+
+		for _, file := range override.Files {
+			if err := file.Validate(); err != nil {
+				return nil, nil, fmt.Errorf("invalid %s for repository %s: %v", overridesKey, name, err)
+			}
+		}
+
+		for _, pattern := range override.Assets {
+			if _, err := filepath.Match(pattern, ""); err != nil {
+				return nil, nil, fmt.Errorf("invalid %s for repository %s: asset %s: %v", overridesKey, name, pattern, err)
+			}
+		}
+	}
+
+	return scheme, overrides, nil
+}
+
+// parseOrgs parses the organizations and team, a team is only released alone.
+func parseOrgs(orgs []string, team string) ([]string, string, error) {
+	if team == "" {
+		return orgs, "", nil
+	}
+
+	if len(orgs) > 1 {
+		return nil, "", fmt.Errorf("invalid %s '%s' can't be combined with several organizations %s", TeamFlag, team, strings.Join(orgs, ", "))
+	}
+
+	var org string
+	if len(orgs) == 1 {
+		org = orgs[0]
+	}
+
+	org, team, err := parseTeam(org, team)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return []string{org}, team, nil
+}
+
+// parseTeam parses team as org/team-slug, or a team slug of org.
+func parseTeam(org, team string) (string, string, error) {
+	if team == "" {
+		return org, "", nil
+	}
+
+	parts := strings.Split(team, "/")
+	switch {
+	case len(parts) == 1 && org != "":
+		return org, team, nil
+	case len(parts) == 2 && parts[0] != "" && parts[1] != "":
+		if org != "" && !strings.EqualFold(org, parts[0]) {
+			return "", "", fmt.Errorf("invalid %s '%s' is not a team of organization %s", TeamFlag, team, org)
+		}
+
+		return parts[0], parts[1], nil
+	default:
+		return "", "", fmt.Errorf("invalid %s '%s' expected org/team-slug", TeamFlag, team)
+	}
+}
+
+// loadAssets parses the assets provided as repo=path by repository.
+func loadAssets() (map[string][]string, error) {
+	assets := make(map[string][]string)
+
+	for _, asset := range viper.GetStringSlice(AssetFlag) {
+		parts := strings.SplitN(asset, "=", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, fmt.Errorf("invalid %s '%s' expected repo=path", AssetFlag, asset)
+		}
+
+		if _, err := filepath.Match(parts[1], ""); err != nil {
+			return nil, fmt.Errorf("invalid %s '%s': %v", AssetFlag, asset, err)
+		}
+
+		name := strings.ToLower(parts[0])
+		assets[name] = append(assets[name], parts[1])
+	}
+
+	return assets, nil
 }
 
 func Load() (*Config, error) {
@@ -199,6 +573,57 @@ func Load() (*Config, error) {
 		return nil, err
 	}
 
+	scheme, overrides, err := loadVersioning()
+	if err != nil {
+		return nil, err
+	}
+
+	readConcurrency, writeConcurrency := viper.GetInt(ReadConcurrencyFlag), viper.GetInt(WriteConcurrencyFlag)
+	if readConcurrency < 1 || writeConcurrency < 1 {
+		return nil, fmt.Errorf("invalid concurrency read %d and write %d expected at least 1", readConcurrency, writeConcurrency)
+	}
+
+	discovery, err := github.DiscoveryFromString(viper.GetString(DiscoveryFlag))
+	if err != nil {
+		return nil, err
+	}
+
+	ci, err := github.CIGateFromString(viper.GetString(CIFlag))
+	if err != nil {
+		return nil, err
+	}
+
+	orgs, team, err := parseOrgs(viper.GetStringSlice(OrgFlag), viper.GetString(TeamFlag))
+	if err != nil {
+		return nil, err
+	}
+
+	// Org is only the organization of a run of a single organization
+	var org string
+	if len(orgs) == 1 {
+		org = orgs[0]
+	}
+
+	assets, err := loadAssets()
+	if err != nil {
+		return nil, err
+	}
+
+	changelog, err := loadChangelog()
+	if err != nil {
+		return nil, err
+	}
+
+	filter, err := loadFilter()
+	if err != nil {
+		return nil, err
+	}
+
+	noise, err := loadNoise()
+	if err != nil {
+		return nil, err
+	}
+
 	dir, err := configDir()
 	if err != nil {
 		return nil, err
@@ -229,12 +654,31 @@ func Load() (*Config, error) {
 		Host:                viper.GetString(HostFlag),
 		Token:               viper.GetString(TokenFlag),
 		Branch:              viper.GetString(BranchFlag),
-		Org:                 viper.GetString(OrgFlag),
+		Org:                 org,
+		Orgs:                orgs,
+		Team:                team,
 		Timeout:             viper.GetDuration(TimeoutFlag),
 		Template:            viper.GetString(TemplateFlag),
 		Repositories:        viper.GetStringSlice(RepositoriesFlag),
 		CreateReleaseBranch: viper.GetBool(CreateReleaseBranchFlag),
+		DryRun:              viper.GetBool(DryRunFlag),
+		Draft:               viper.GetBool(DraftFlag),
+		Atomic:              viper.GetBool(AtomicFlag),
+		ReadConcurrency:     readConcurrency,
+		WriteConcurrency:    writeConcurrency,
+		Discovery:           discovery,
+		CI:                  ci,
+		NoCache:             viper.GetBool(NoCacheFlag),
+		Checksums:           viper.GetBool(ChecksumsFlag),
+		GeneratedNotes:      viper.GetBool(GeneratedNotesFlag),
 		VersionChange:       change,
+		VersionScheme:       scheme,
+		TagTemplate:         viper.GetString(TagTemplateFlag),
+		Overrides:           overrides,
+		Changelog:           changelog,
+		Filter:              filter,
+		Noise:               noise,
+		Assets:              assets,
 		AuthHosts:           authHosts,
 		Terminal:            &TerminalConfig{},
 	}, nil
@@ -272,11 +716,135 @@ func (c *Config) SaveHost(auth Auth) error {
 	return ioutil.WriteFile(hostsPath, out, 0600)
 }
 
-func (c *Config) IsRepositoryToRelease(name string) bool {
+func (c *Config) IsRepositoryToRelease(owner, name string) bool {
 	for _, repo := range c.Repositories {
 		if repo == name {
 			return true
 		}
+
+		// Repositories of several organizations may be qualified by their owner
+		if parts := strings.SplitN(repo, "/", 2); len(parts) == 2 && strings.EqualFold(parts[0], owner) && parts[1] == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Override the Override for repository name, viper keys are case insensitive.
+func (c *Config) Override(name string) (Override, bool) {
+	override, ok := c.Overrides[strings.ToLower(name)]
+	return override, ok
+}
+
+// Scheme the version scheme for repository name, either from its Override or the global version scheme.
+func (c *Config) Scheme(name string) version.Scheme {
+	override, ok := c.Override(name)
+	if !ok || override.Version.Scheme == "" {
+		return c.VersionScheme
+	}
+
+	// Validated when loaded
+	scheme, err := version.SchemeFromString(override.Version.Scheme, override.Version.Format)
+	if err != nil {
+		return c.VersionScheme
+	}
+
+	return scheme
+}
+
+// TagFormat the configured tag format for repository name, nil when tags follow the format of the latest tag.
+func (c *Config) TagFormat(name string) *version.TagFormat {
+	tagTemplate := c.TagTemplate
+	if override, ok := c.Override(name); ok && override.Version.TagTemplate != "" {
+		tagTemplate = override.Version.TagTemplate
+	}
+
+	if tagTemplate == "" {
+		return nil
+	}
+
+	// Validated when loaded
+	format, err := version.TagFormatFromTemplate(tagTemplate)
+	if err != nil {
+		return nil
+	}
+
+	return &format
+}
+
+// MatchesTag whether tag is a version of the version scheme and follows the configured tag format for repository name.
+func (c *Config) MatchesTag(name, tag string) bool {
+	return version.ValidTag(c.Scheme(name), c.TagFormat(name), tag)
+}
+
+// CompareTags compares the versions of tags a and b for repository name returning -1, 0 or 1, tags that aren't
+// versions are equal.
+func (c *Config) CompareTags(name, a, b string) int {
+	result, _ := version.CompareTags(c.Scheme(name), a, b)
+	return result
+}
+
+// VersionFiles files containing the version that are updated prior to creating a release of repository name.
+func (c *Config) VersionFiles(name string) []github.VersionFile {
+	override, ok := c.Override(name)
+	if !ok {
+		return nil
+	}
+
+	return override.Files
+}
+
+// AssetFiles files uploaded to a release of repository name matched by the glob patterns of its Override and those
+// provided by flag, patterns without matches are kept so creating the release fails instead of silently missing assets.
+func (c *Config) AssetFiles(name string) []string {
+	override, _ := c.Override(name)
+	patterns := append(append([]string{}, override.Assets...), c.Assets[strings.ToLower(name)]...)
+
+	var files []string
+	seen := make(map[string]bool)
+	for _, pattern := range patterns {
+		// Validated when loaded
+		matches, _ := filepath.Glob(pattern)
+		if len(matches) == 0 {
+			matches = []string{pattern}
+		}
+
+		for _, match := range matches {
+			if seen[match] {
+				continue
+			}
+
+			seen[match] = true
+			files = append(files, match)
+		}
+	}
+
+	return files
+}
+
+// UsesGeneratedNotes whether the release notes generated by GitHub are the body of releases or used by the template.
+func (c *Config) UsesGeneratedNotes() bool {
+	return c.GeneratedNotes || strings.Contains(c.Template, ".GeneratedNotes")
+}
+
+// UsesFileStats whether the template uses the files changed, additions or deletions since the latest tag.
+func (c *Config) UsesFileStats() bool {
+	for _, variable := range []string{".FilesChanged", ".Additions", ".Deletions"} {
+		if strings.Contains(c.Template, variable) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// UsesPullRequests whether the template uses the pull requests of commits, directly or grouped into sections.
+func (c *Config) UsesPullRequests() bool {
+	for _, variable := range []string{".PullRequests", ".PR", ".Sections"} {
+		if strings.Contains(c.Template, variable) {
+			return true
+		}
 	}
 
 	return false
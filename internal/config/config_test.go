@@ -1,10 +1,14 @@
 package config
 
 import (
+	"io/ioutil"
 	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/NickHackman/releaser/internal/version"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestCheckAuth(t *testing.T) {
@@ -49,15 +53,18 @@ func TestCheckAuth(t *testing.T) {
 }
 
 func TestIsRepositoryToRelease(t *testing.T) {
-	repositories := []string{"example1", "example2"}
+	repositories := []string{"example1", "example2", "shared/library"}
 
 	tests := []struct {
 		name           string
+		owner          string
 		repositoryName string
 		shouldRelease  bool
 	}{
-		{name: "should release", repositoryName: "example1", shouldRelease: true},
-		{name: "should not release", repositoryName: "invalid"},
+		{name: "should release", owner: "example", repositoryName: "example1", shouldRelease: true},
+		{name: "should not release", owner: "example", repositoryName: "invalid"},
+		{name: "should release qualified", owner: "Shared", repositoryName: "library", shouldRelease: true},
+		{name: "should not release qualified of other owner", owner: "example", repositoryName: "library"},
 	}
 
 	for _, test := range tests {
@@ -66,7 +73,7 @@ func TestIsRepositoryToRelease(t *testing.T) {
 				Repositories: repositories,
 			}
 
-			shouldRelease := c.IsRepositoryToRelease(test.repositoryName)
+			shouldRelease := c.IsRepositoryToRelease(test.owner, test.repositoryName)
 			assert.Equal(t, test.shouldRelease, shouldRelease)
 		})
 	}
@@ -98,3 +105,170 @@ func TestSetSize(t *testing.T) {
 	assert.Equal(t, 5, w)
 	assert.Equal(t, 6, h)
 }
+
+func TestScheme(t *testing.T) {
+	c := Config{
+		VersionScheme: version.SemVer{},
+		Overrides: map[string]Override{
+			"service-a": {Version: VersionOverride{Scheme: version.CalVerScheme, Format: "YYYY.MM.DD.N"}},
+			"service-b": {},
+		},
+	}
+
+	assert.IsType(t, version.SemVer{}, c.Scheme("example"))
+	assert.IsType(t, version.SemVer{}, c.Scheme("service-b"))
+	assert.IsType(t, &version.CalVer{}, c.Scheme("service-a"))
+	assert.IsType(t, &version.CalVer{}, c.Scheme("Service-A"))
+}
+
+func TestMatchesTag(t *testing.T) {
+	c := Config{
+		VersionScheme: version.SemVer{},
+		Overrides: map[string]Override{
+			"monorepo": {Version: VersionOverride{TagTemplate: "service-a/v{{ .Version }}"}},
+			"calver":   {Version: VersionOverride{Scheme: version.CalVerScheme, Format: "YYYY.MM.DD.N"}},
+		},
+	}
+
+	assert.Nil(t, c.TagFormat("example"))
+	assert.True(t, c.MatchesTag("example", "v1.2.3"))
+	assert.False(t, c.MatchesTag("example", "nightly"))
+	assert.True(t, c.MatchesTag("calver", "2022.1.31.2"))
+	assert.False(t, c.MatchesTag("calver", "v1.2.3"))
+
+	assert.Equal(t, &version.TagFormat{Prefix: "service-a/v"}, c.TagFormat("monorepo"))
+	assert.True(t, c.MatchesTag("monorepo", "service-a/v1.2.3"))
+	assert.False(t, c.MatchesTag("monorepo", "service-b/v1.2.3"))
+
+	c.TagTemplate = "release-{{ .Version }}"
+	assert.Equal(t, &version.TagFormat{Prefix: "release-"}, c.TagFormat("example"))
+	assert.False(t, c.MatchesTag("example", "v1.2.3"))
+}
+
+func TestCompareTags(t *testing.T) {
+	c := Config{VersionScheme: version.SemVer{}}
+
+	assert.Equal(t, -1, c.CompareTags("example", "v1.2.3", "v1.10.0"))
+	assert.Equal(t, 1, c.CompareTags("example", "v2.0.0", "v2.0.0-rc.1"))
+	assert.Equal(t, 0, c.CompareTags("example", "v1.0.0", "1.0.0"))
+	assert.Equal(t, 0, c.CompareTags("example", "nightly", "v1.0.0"))
+}
+
+func TestAssetFiles(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"a.tar.gz", "b.tar.gz", "checksums.txt"} {
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, name), nil, 0644))
+	}
+
+	c := Config{
+		Overrides: map[string]Override{"example": {Assets: []string{filepath.Join(dir, "*.tar.gz")}}},
+		Assets:    map[string][]string{"example": {filepath.Join(dir, "a.tar.gz"), filepath.Join(dir, "missing.zip")}},
+	}
+
+	expected := []string{filepath.Join(dir, "a.tar.gz"), filepath.Join(dir, "b.tar.gz"), filepath.Join(dir, "missing.zip")}
+	assert.Equal(t, expected, c.AssetFiles("Example"))
+	assert.Nil(t, c.AssetFiles("other"))
+}
+
+func TestUsesGeneratedNotes(t *testing.T) {
+	assert.False(t, (&Config{Template: "{{ range .Commits }}{{ .Summary }}{{ end }}"}).UsesGeneratedNotes())
+	assert.True(t, (&Config{Template: "{{ .GeneratedNotes }}"}).UsesGeneratedNotes())
+	assert.True(t, (&Config{GeneratedNotes: true}).UsesGeneratedNotes())
+}
+
+func TestUsesFileStats(t *testing.T) {
+	assert.False(t, (&Config{Template: "{{ range .Commits }}{{ .Summary }}{{ end }}"}).UsesFileStats())
+	assert.True(t, (&Config{Template: "{{ .FilesChanged }} files changed"}).UsesFileStats())
+	assert.True(t, (&Config{Template: "+{{ .Additions }} -{{ .Deletions }}"}).UsesFileStats())
+}
+
+func TestUsesPullRequests(t *testing.T) {
+	assert.False(t, (&Config{Template: "{{ range .Commits }}{{ .Summary }}{{ end }}"}).UsesPullRequests())
+	assert.True(t, (&Config{Template: "{{ range .PullRequests }}{{ .Title }}{{ end }}"}).UsesPullRequests())
+	assert.True(t, (&Config{Template: "{{ range .Commits }}{{ .PR.Title }}{{ end }}"}).UsesPullRequests())
+	assert.True(t, (&Config{Template: "{{ range .Sections }}{{ .Title }}{{ end }}"}).UsesPullRequests())
+}
+
+func TestChangelogSection(t *testing.T) {
+	changelog := Changelog{
+		Sections: []ChangelogSection{
+			{Title: "Features", Labels: []string{"enhancement", "feature"}},
+			{Title: "Fixes", Labels: []string{"bug"}},
+		},
+		Exclude: []string{"skip-changelog"},
+		Other:   "Other Changes",
+	}
+
+	tests := []struct {
+		name     string
+		labels   []string
+		expected string
+		included bool
+	}{
+		{name: "Section", labels: []string{"Enhancement"}, expected: "Features", included: true},
+		{name: "First section in order", labels: []string{"bug", "feature"}, expected: "Features", included: true},
+		{name: "Excluded", labels: []string{"bug", "skip-changelog"}, expected: "", included: false},
+		{name: "Other", labels: []string{"documentation"}, expected: "Other Changes", included: true},
+		{name: "No labels", labels: nil, expected: "Other Changes", included: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, included := changelog.Section(test.labels)
+			assert.Equal(t, test.expected, actual)
+			assert.Equal(t, test.included, included)
+		})
+	}
+
+	changelog.Other = ""
+	_, included := changelog.Section([]string{"documentation"})
+	assert.False(t, included)
+}
+
+func TestParseTeam(t *testing.T) {
+	tests := []struct {
+		name         string
+		org          string
+		team         string
+		expectedOrg  string
+		expectedTeam string
+		err          bool
+	}{
+		{name: "No team", org: "example", expectedOrg: "example"},
+		{name: "Org and slug", team: "example/squad-a", expectedOrg: "example", expectedTeam: "squad-a"},
+		{name: "Slug of org", org: "example", team: "squad-a", expectedOrg: "example", expectedTeam: "squad-a"},
+		{name: "Same org", org: "Example", team: "example/squad-a", expectedOrg: "example", expectedTeam: "squad-a"},
+		{name: "Different org", org: "other", team: "example/squad-a", err: true},
+		{name: "Slug without org", team: "squad-a", err: true},
+		{name: "Invalid", team: "example/", err: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			org, team, err := parseTeam(test.org, test.team)
+			if test.err {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedOrg, org)
+			assert.Equal(t, test.expectedTeam, team)
+		})
+	}
+}
+
+func TestParseOrgs(t *testing.T) {
+	orgs, team, err := parseOrgs([]string{"example", "shared"}, "")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"example", "shared"}, orgs)
+	assert.Equal(t, "", team)
+
+	orgs, team, err = parseOrgs(nil, "example/squad-a")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"example"}, orgs)
+	assert.Equal(t, "squad-a", team)
+
+	_, _, err = parseOrgs([]string{"example", "shared"}, "example/squad-a")
+	assert.Error(t, err)
+}
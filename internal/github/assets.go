@@ -0,0 +1,128 @@
+package github
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/go-github/v41/github"
+)
+
+const (
+	// ChecksumsFilename name of the asset containing the SHA-256 checksums of every other asset in the format of sha256sum.
+	ChecksumsFilename = "checksums.txt"
+	// sniffLen bytes used to detect the content type of an asset without a known extension.
+	sniffLen = 512
+)
+
+// uploadAssets uploads the assets of a release followed by their checksums when enabled.
+func (gh *Client) uploadAssets(ctx context.Context, owner string, releaseInfo *RepositoryRelease, id int64) error {
+	if len(releaseInfo.Assets) == 0 {
+		return nil
+	}
+
+	for _, path := range releaseInfo.Assets {
+		if err := gh.uploadAsset(ctx, owner, releaseInfo.Name, id, filepath.Base(path), path); err != nil {
+			return err
+		}
+	}
+
+	if !releaseInfo.Checksums {
+		return nil
+	}
+
+	sums, err := checksums(releaseInfo.Assets)
+	if err != nil {
+		return err
+	}
+
+	f, err := ioutil.TempFile("", "releaser-checksums-*.txt")
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %v", ChecksumsFilename, err)
+	}
+	defer os.Remove(f.Name())
+
+	_, err = f.WriteString(sums)
+	f.Close()
+	if err != nil {
+		return fmt.Errorf("failed to write %s: %v", ChecksumsFilename, err)
+	}
+
+	return gh.uploadAsset(ctx, owner, releaseInfo.Name, id, ChecksumsFilename, f.Name())
+}
+
+// uploadAsset uploads the file at path to the release id as name.
+func (gh *Client) uploadAsset(ctx context.Context, owner, repo string, id int64, name, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open asset %s: %v", path, err)
+	}
+	defer f.Close()
+
+	mediaType, err := contentType(f)
+	if err != nil {
+		return fmt.Errorf("failed to detect content type of asset %s: %v", path, err)
+	}
+
+	options := &github.UploadOptions{Name: name, MediaType: mediaType}
+	_, r, err := gh.client.Repositories.UploadReleaseAsset(ctx, owner, repo, id, options, f)
+	if err != nil {
+		return fmt.Errorf("failed to upload asset %s: %v", name, err)
+	}
+
+	if err := github.CheckResponse(r.Response); err != nil {
+		return fmt.Errorf("failed to upload asset %s: %v", name, err)
+	}
+
+	return nil
+}
+
+// contentType of f from its extension, otherwise sniffed from its content.
+func contentType(f *os.File) (string, error) {
+	if mediaType := mime.TypeByExtension(filepath.Ext(f.Name())); mediaType != "" {
+		return mediaType, nil
+	}
+
+	buf := make([]byte, sniffLen)
+	n, err := io.ReadFull(f, buf)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return "", err
+	}
+
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+
+	return http.DetectContentType(buf[:n]), nil
+}
+
+// checksums SHA-256 checksums of the files at paths in the format of sha256sum.
+func checksums(paths []string) (string, error) {
+	var b strings.Builder
+
+	for _, path := range paths {
+		f, err := os.Open(path)
+		if err != nil {
+			return "", fmt.Errorf("failed to open asset %s: %v", path, err)
+		}
+
+		hash := sha256.New()
+		_, err = io.Copy(hash, f)
+		f.Close()
+		if err != nil {
+			return "", fmt.Errorf("failed to read asset %s: %v", path, err)
+		}
+
+		fmt.Fprintf(&b, "%s  %s\n", hex.EncodeToString(hash.Sum(nil)), filepath.Base(path))
+	}
+
+	return b.String(), nil
+}
@@ -0,0 +1,96 @@
+package github
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeAsset(t *testing.T, name, content string) string {
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, ioutil.WriteFile(path, []byte(content), 0644))
+
+	return path
+}
+
+func TestContentType(t *testing.T) {
+	tests := []struct {
+		name     string
+		filename string
+		content  string
+		expected string
+	}{
+		{name: "Known extension", filename: "release.json", content: "{}", expected: "application/json"},
+		{name: "Sniffed gzip", filename: "release", content: "\x1f\x8b\x08\x00", expected: "application/x-gzip"},
+		{name: "Sniffed text", filename: "LICENSE", content: "MIT License", expected: "text/plain; charset=utf-8"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			f, err := os.Open(writeAsset(t, test.filename, test.content))
+			require.NoError(t, err)
+			defer f.Close()
+
+			actual, err := contentType(f)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, actual)
+
+			// Sniffing must not consume the file
+			content, err := ioutil.ReadAll(f)
+			require.NoError(t, err)
+			assert.Equal(t, test.content, string(content))
+		})
+	}
+}
+
+func TestChecksums(t *testing.T) {
+	paths := []string{writeAsset(t, "a.txt", "hello\n"), writeAsset(t, "b.txt", "")}
+
+	actual, err := checksums(paths)
+	require.NoError(t, err)
+
+	expected := "5891b5b522d5df086d0ff0b110fbd9d21bb4fc7163af34d08286a2e846f6be03  a.txt\n" +
+		"e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855  b.txt\n"
+	assert.Equal(t, expected, actual)
+
+	_, err = checksums([]string{filepath.Join(t.TempDir(), "missing")})
+	assert.Error(t, err)
+}
+
+func TestUploadAssets(t *testing.T) {
+	uploaded := make(map[string]string)
+
+	gh := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/repos/example/example1/releases/1/assets" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+
+		uploaded[r.URL.Query().Get("name")] = string(body)
+		assert.NotEmpty(t, r.Header.Get("Content-Type"))
+
+		_, _ = w.Write([]byte(`{"id": 1}`))
+	})
+
+	release := &RepositoryRelease{
+		Name:      "example1",
+		Assets:    []string{writeAsset(t, "example1.tar.gz", "hello\n")},
+		Checksums: true,
+	}
+
+	require.NoError(t, gh.uploadAssets(context.Background(), "example", release, 1))
+
+	expected := map[string]string{
+		"example1.tar.gz": "hello\n",
+		ChecksumsFilename: "5891b5b522d5df086d0ff0b110fbd9d21bb4fc7163af34d08286a2e846f6be03  example1.tar.gz\n",
+	}
+	assert.Equal(t, expected, uploaded)
+}
@@ -4,15 +4,28 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
 	"net/url"
+	"strings"
 
 	"github.com/google/go-github/v41/github"
 	"golang.org/x/oauth2"
 )
 
 type Builder struct {
-	url   string
-	token string
+	url        string
+	uploadURL  string
+	graphqlURL string
+	token      string
+	tagFilter  func(repo, tag string) bool
+	tagCompare func(repo, a, b string) int
+	repoFilter func(repo *github.Repository) bool
+	noise      *NoiseFilter
+	reads      int
+	writes     int
+	discovery  Discovery
+	fileStats  bool
+	cacheDir   string
 }
 
 func New() *Builder {
@@ -21,13 +34,19 @@ func New() *Builder {
 
 func (ghb *Builder) Host(host string) *Builder {
 	url := "https://api.github.com/"
+	uploadURL := "https://uploads.github.com/"
+	graphqlURL := "https://api.github.com/graphql"
 
 	// Handle GitHub Enterprise
 	if host != "github.com" {
 		url = fmt.Sprintf("https://%s/api/v3/", host)
+		uploadURL = fmt.Sprintf("https://%s/api/uploads/", host)
+		graphqlURL = fmt.Sprintf("https://%s/api/graphql", host)
 	}
 
 	ghb.url = url
+	ghb.uploadURL = uploadURL
+	ghb.graphqlURL = graphqlURL
 	return ghb
 }
 
@@ -36,6 +55,60 @@ func (ghb *Builder) Token(token string) *Builder {
 	return ghb
 }
 
+// TagFilter only consider tags that filter accepts when determining the latest tag of a repository.
+func (ghb *Builder) TagFilter(filter func(repo, tag string) bool) *Builder {
+	ghb.tagFilter = filter
+	return ghb
+}
+
+// TagCompare orders tags of a repository returning -1, 0 or 1, used to pick the highest tag when several tags point to
+// the same commit and the highest tag of a repository.
+func (ghb *Builder) TagCompare(compare func(repo, a, b string) int) *Builder {
+	ghb.tagCompare = compare
+	return ghb
+}
+
+// RepositoryFilter only consider repositories that filter accepts, applied before reading their tags and commits.
+func (ghb *Builder) RepositoryFilter(filter func(repo *github.Repository) bool) *Builder {
+	ghb.repoFilter = filter
+	return ghb
+}
+
+// Noise discounts commits matching filter, repositories with only noise since their latest tag aren't releasable.
+//
+// filter is used by reference so changes to it apply to the Client.
+func (ghb *Builder) Noise(filter *NoiseFilter) *Builder {
+	ghb.noise = filter
+	return ghb
+}
+
+// Concurrency limits how many repositories are read concurrently while discovering releasable repositories, and
+// how many are written concurrently while creating releases.
+func (ghb *Builder) Concurrency(reads, writes int) *Builder {
+	ghb.reads = reads
+	ghb.writes = writes
+	return ghb
+}
+
+// Cache GET responses in dir revalidating them with conditional requests, responses aren't cached when dir is empty.
+func (ghb *Builder) Cache(dir string) *Builder {
+	ghb.cacheDir = dir
+	return ghb
+}
+
+// Discovery how releasable repositories are discovered, defaults to RESTDiscovery.
+func (ghb *Builder) Discovery(discovery Discovery) *Builder {
+	ghb.discovery = discovery
+	return ghb
+}
+
+// FileStats whether GraphQL discovery compares repositories to their latest tag for the files changed, additions and
+// deletions, REST discovery always compares.
+func (ghb *Builder) FileStats(fileStats bool) *Builder {
+	ghb.fileStats = fileStats
+	return ghb
+}
+
 func (ghb *Builder) Build() (*Client, error) {
 	if ghb.token == "" {
 		return nil, errors.New("failed to authenticate missing GitHub Oauth token.\nRun `releaser login`")
@@ -43,6 +116,15 @@ func (ghb *Builder) Build() (*Client, error) {
 
 	ts := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: ghb.token})
 	tc := oauth2.NewClient(context.Background(), ts)
+
+	var base http.RoundTripper
+	if ghb.cacheDir != "" {
+		base = newCacheTransport(nil, ghb.cacheDir)
+	}
+
+	transport := newRateLimitTransport(base)
+	tc.Transport.(*oauth2.Transport).Base = transport
+
 	client := github.NewClient(tc)
 
 	var err error
@@ -51,5 +133,58 @@ func (ghb *Builder) Build() (*Client, error) {
 		return nil, err
 	}
 
-	return &Client{client: client}, nil
+	client.UploadURL, err = url.Parse(ghb.uploadURL)
+	if err != nil {
+		return nil, err
+	}
+
+	tagFilter := ghb.tagFilter
+	if tagFilter == nil {
+		tagFilter = func(repo, tag string) bool { return true }
+	}
+
+	tagCompare := ghb.tagCompare
+	if tagCompare == nil {
+		tagCompare = func(repo, a, b string) int { return strings.Compare(a, b) }
+	}
+
+	repoFilter := ghb.repoFilter
+	if repoFilter == nil {
+		repoFilter = func(repo *github.Repository) bool { return true }
+	}
+
+	noise := ghb.noise
+	if noise == nil {
+		noise = &NoiseFilter{}
+	}
+
+	reads := ghb.reads
+	if reads <= 0 {
+		reads = defaultReadConcurrency
+	}
+
+	writes := ghb.writes
+	if writes <= 0 {
+		writes = defaultWriteConcurrency
+	}
+
+	discovery := ghb.discovery
+	if discovery == "" {
+		discovery = RESTDiscovery
+	}
+
+	return &Client{
+		client:     client,
+		tagFilter:  tagFilter,
+		tagCompare: tagCompare,
+		repoFilter: repoFilter,
+		noise:      noise,
+		reads:      newPool(reads),
+		writes:     newPool(writes),
+		transport:  transport,
+		http:       tc,
+		graphqlURL: ghb.graphqlURL,
+		discovery:  discovery,
+		fileStats:  ghb.fileStats,
+	}, nil
 }
@@ -8,12 +8,13 @@ import (
 
 func TestBuilderHost(t *testing.T) {
 	tests := []struct {
-		name     string
-		input    string
-		expected string
+		name           string
+		input          string
+		expected       string
+		expectedUpload string
 	}{
-		{name: "github.com", input: "github.com", expected: "https://api.github.com/"},
-		{name: "GitHub enterprise", input: "git.enterprise.com", expected: "https://git.enterprise.com/api/v3/"},
+		{name: "github.com", input: "github.com", expected: "https://api.github.com/", expectedUpload: "https://uploads.github.com/"},
+		{name: "GitHub enterprise", input: "git.enterprise.com", expected: "https://git.enterprise.com/api/v3/", expectedUpload: "https://git.enterprise.com/api/uploads/"},
 	}
 
 	for _, test := range tests {
@@ -21,6 +22,7 @@ func TestBuilderHost(t *testing.T) {
 			b := New().Host(test.input)
 
 			assert.Equal(t, test.expected, b.url)
+			assert.Equal(t, test.expectedUpload, b.uploadURL)
 		})
 	}
 }
@@ -38,3 +40,30 @@ func TestBuilderValid(t *testing.T) {
 
 	assert.NotNil(t, gh)
 }
+
+func TestBuilderTagFilter(t *testing.T) {
+	gh, err := New().Token("token").Build()
+	assert.NoError(t, err)
+	assert.True(t, gh.tagFilter("example", "v1.0.0"))
+
+	gh, err = New().Token("token").TagFilter(func(repo, tag string) bool { return tag == "v1.0.0" }).Build()
+	assert.NoError(t, err)
+	assert.True(t, gh.tagFilter("example", "v1.0.0"))
+	assert.False(t, gh.tagFilter("example", "nightly"))
+}
+
+func TestBuilderNoise(t *testing.T) {
+	gh, err := New().Token("token").Build()
+	assert.NoError(t, err)
+	assert.True(t, gh.noise.IsEmpty())
+
+	noise := NoiseFilter{Authors: []string{"dependabot[bot]"}}
+
+	gh, err = New().Token("token").Noise(&noise).Build()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"dependabot[bot]"}, gh.noise.Authors)
+
+	// Refreshed noise applies to the client
+	noise = NoiseFilter{Authors: []string{"renovate[bot]"}}
+	assert.Equal(t, []string{"renovate[bot]"}, gh.noise.Authors)
+}
@@ -0,0 +1,200 @@
+package github
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+const (
+	headerETag            = "ETag"
+	headerLastModified    = "Last-Modified"
+	headerIfNoneMatch     = "If-None-Match"
+	headerIfModifiedSince = "If-Modified-Since"
+
+	// cacheMaxAge how long a cached response that isn't used is kept.
+	cacheMaxAge = 30 * 24 * time.Hour
+)
+
+// cachedResponse a GET response stored on disk.
+type cachedResponse struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header"`
+	Body       []byte      `json:"body"`
+}
+
+// cacheTransport caches GET responses in dir and revalidates them with conditional requests, GitHub doesn't count
+// responses that weren't modified against the rate limit.
+//
+// Responses that weren't used for cacheMaxAge are pruned on the first write.
+type cacheTransport struct {
+	base  http.RoundTripper
+	dir   string
+	prune sync.Once
+}
+
+func newCacheTransport(base http.RoundTripper, dir string) *cacheTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	return &cacheTransport{base: base, dir: dir}
+}
+
+func (t *cacheTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet {
+		return t.base.RoundTrip(req)
+	}
+
+	path := t.path(req)
+	cached := t.read(path)
+
+	if cached != nil {
+		req = req.Clone(req.Context())
+
+		if etag := cached.Header.Get(headerETag); etag != "" {
+			req.Header.Set(headerIfNoneMatch, etag)
+		}
+
+		if modified := cached.Header.Get(headerLastModified); modified != "" {
+			req.Header.Set(headerIfModifiedSince, modified)
+		}
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotModified && cached != nil {
+		resp.Body.Close()
+
+		// Used responses aren't pruned
+		now := time.Now()
+		_ = os.Chtimes(path, now, now)
+
+		// Fresh headers such as the rate limit take precedence
+		header := cached.Header.Clone()
+		for key, values := range resp.Header {
+			header[key] = values
+		}
+
+		return &http.Response{
+			Status:        http.StatusText(cached.StatusCode),
+			StatusCode:    cached.StatusCode,
+			Proto:         resp.Proto,
+			ProtoMajor:    resp.ProtoMajor,
+			ProtoMinor:    resp.ProtoMinor,
+			Header:        header,
+			Body:          ioutil.NopCloser(bytes.NewReader(cached.Body)),
+			ContentLength: int64(len(cached.Body)),
+			Request:       req,
+		}, nil
+	}
+
+	if resp.StatusCode != http.StatusOK || (resp.Header.Get(headerETag) == "" && resp.Header.Get(headerLastModified) == "") {
+		return resp, nil
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	// A cache that fails to write only costs rate limit
+	t.write(path, &cachedResponse{StatusCode: resp.StatusCode, Header: resp.Header, Body: body})
+
+	return resp, nil
+}
+
+// path of the cached response of req, responses are cached per token.
+func (t *cacheTransport) path(req *http.Request) string {
+	hash := sha256.New()
+	for _, part := range []string{req.URL.String(), req.Header.Get("Accept"), req.Header.Get("Authorization")} {
+		hash.Write([]byte(part))
+		hash.Write([]byte{0})
+	}
+
+	return filepath.Join(t.dir, hex.EncodeToString(hash.Sum(nil)))
+}
+
+func (t *cacheTransport) read(path string) *cachedResponse {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+
+	var cached cachedResponse
+	if err := json.Unmarshal(content, &cached); err != nil {
+		return nil
+	}
+
+	return &cached
+}
+
+func (t *cacheTransport) write(path string, cached *cachedResponse) {
+	content, err := json.Marshal(cached)
+	if err != nil {
+		return
+	}
+
+	if err := os.MkdirAll(t.dir, 0700); err != nil {
+		return
+	}
+
+	t.prune.Do(func() { pruneCache(t.dir, time.Now().Add(-cacheMaxAge)) })
+
+	// Write then rename, so concurrent readers never read a partial response
+	f, err := ioutil.TempFile(t.dir, "tmp-")
+	if err != nil {
+		return
+	}
+
+	_, err = f.Write(content)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		os.Remove(f.Name())
+		return
+	}
+
+	if err := os.Rename(f.Name(), path); err != nil {
+		os.Remove(f.Name())
+	}
+}
+
+// pruneCache removes the responses cached in dir that weren't used since before.
+func pruneCache(dir string, before time.Time) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return
+	}
+
+	for _, file := range files {
+		if !file.IsDir() && file.ModTime().Before(before) {
+			os.Remove(filepath.Join(dir, file.Name()))
+		}
+	}
+}
+
+// ClearCache removes every response cached in dir.
+func ClearCache(dir string) error {
+	if err := os.RemoveAll(dir); err != nil {
+		return fmt.Errorf("failed to clear cache %s: %v", dir, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,103 @@
+package github
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCacheTransport(t *testing.T) {
+	var requests, notModified int
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set(headerRateRemaining, string(rune('0'+requests)))
+
+		if r.Header.Get(headerIfNoneMatch) == `"v1"` {
+			notModified++
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		w.Header().Set(headerETag, `"v1"`)
+		_, _ = w.Write([]byte(`[{"name": "v1.0.0"}]`))
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	transport := newCacheTransport(nil, dir)
+
+	for i := 1; i <= 2; i++ {
+		req, err := http.NewRequest(http.MethodGet, server.URL+"/repos/example/example1/tags", nil)
+		require.NoError(t, err)
+
+		resp, err := transport.RoundTrip(req)
+		require.NoError(t, err)
+
+		body, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		require.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, `[{"name": "v1.0.0"}]`, string(body))
+		assert.Equal(t, string(rune('0'+i)), resp.Header.Get(headerRateRemaining))
+	}
+
+	assert.Equal(t, 2, requests)
+	assert.Equal(t, 1, notModified)
+
+	// Writes aren't cached
+	req, err := http.NewRequest(http.MethodPost, server.URL+"/repos/example/example1/releases", nil)
+	require.NoError(t, err)
+
+	resp, err := transport.RoundTrip(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	assert.Equal(t, 3, requests)
+	assert.Equal(t, 1, notModified)
+
+	require.NoError(t, ClearCache(dir))
+
+	files, err := ioutil.ReadDir(dir)
+	assert.Error(t, err)
+	assert.Empty(t, files)
+}
+
+func TestCacheTransportPrune(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerETag, `"v1"`)
+		_, _ = w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+
+	stale := filepath.Join(dir, "stale")
+	require.NoError(t, ioutil.WriteFile(stale, []byte(`{}`), 0600))
+	old := time.Now().Add(-cacheMaxAge - time.Hour)
+	require.NoError(t, os.Chtimes(stale, old, old))
+
+	recent := filepath.Join(dir, "recent")
+	require.NoError(t, ioutil.WriteFile(recent, []byte(`{}`), 0600))
+
+	transport := newCacheTransport(nil, dir)
+
+	req, err := http.NewRequest(http.MethodGet, server.URL+"/repos/example/example1/tags", nil)
+	require.NoError(t, err)
+
+	resp, err := transport.RoundTrip(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	assert.NoFileExists(t, stale)
+	assert.FileExists(t, recent)
+	assert.FileExists(t, transport.path(req))
+}
@@ -0,0 +1,179 @@
+package github
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-github/v41/github"
+)
+
+// CIState combined state of the commit statuses and check runs of a commit.
+type CIState string
+
+const (
+	// CINone the commit has neither statuses nor check runs.
+	CINone    CIState = ""
+	CISuccess CIState = "success"
+	CIPending CIState = "pending"
+	CIFailure CIState = "failure"
+)
+
+// CIGate what happens when creating a release of a commit whose CI is failing or pending.
+type CIGate string
+
+const (
+	// CIGateOff doesn't check CI.
+	CIGateOff CIGate = "off"
+	// CIGateWarn creates the release with a warning.
+	CIGateWarn CIGate = "warn"
+	// CIGateRefuse doesn't create the release.
+	CIGateRefuse CIGate = "refuse"
+)
+
+// CIGateFromString parses gate, defaults to CIGateOff when empty.
+func CIGateFromString(gate string) (CIGate, error) {
+	if gate == "" {
+		return CIGateOff, nil
+	}
+
+	g := CIGate(gate)
+	if g != CIGateOff && g != CIGateWarn && g != CIGateRefuse {
+		return "", fmt.Errorf("invalid ci '%s' expected one of 'off', 'warn', 'refuse'", gate)
+	}
+
+	return g, nil
+}
+
+// failing check run conclusions, the others (success, neutral, skipped and stale) don't fail CI.
+var failingConclusions = []string{"failure", "timed_out", "cancelled", "action_required", "startup_failure"}
+
+// CI the combined state of the commit statuses and check runs of sha, failing when any failed otherwise pending when
+// any is pending.
+func (gh *Client) CI(ctx context.Context, owner, repo, sha string) (CIState, error) {
+	var states []CIState
+
+	err := gh.reads.Do(ctx, func() error {
+		status, r, err := gh.client.Repositories.GetCombinedStatus(ctx, owner, repo, sha, &github.ListOptions{PerPage: githubMaxPerPage})
+		if err != nil {
+			return fmt.Errorf("failed to get combined status of %s/%s@%s: %v", owner, repo, sha, err)
+		}
+
+		if err := github.CheckResponse(r.Response); err != nil {
+			return fmt.Errorf("failed to get combined status of %s/%s@%s: %v", owner, repo, sha, err)
+		}
+
+		// A commit without statuses has the combined state pending
+		if status.GetTotalCount() > 0 {
+			states = append(states, statusState(status.GetState()))
+		}
+
+		checks, err := gh.checkRunStates(ctx, owner, repo, sha)
+		if err != nil {
+			return err
+		}
+
+		states = append(states, checks...)
+		return nil
+	})
+	if err != nil {
+		return CINone, err
+	}
+
+	return combineStates(states), nil
+}
+
+// checkRunStates the state of every check run of sha.
+func (gh *Client) checkRunStates(ctx context.Context, owner, repo, sha string) ([]CIState, error) {
+	next := 1
+
+	var states []CIState
+	for {
+		options := &github.ListCheckRunsOptions{ListOptions: github.ListOptions{Page: next, PerPage: githubMaxPerPage}}
+
+		result, r, err := gh.client.Checks.ListCheckRunsForRef(ctx, owner, repo, sha, options)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list check runs of %s/%s@%s: %v", owner, repo, sha, err)
+		}
+
+		if err := github.CheckResponse(r.Response); err != nil {
+			return nil, fmt.Errorf("failed to list check runs of %s/%s@%s: %v", owner, repo, sha, err)
+		}
+
+		for _, run := range result.CheckRuns {
+			states = append(states, checkRunState(run))
+		}
+
+		next = r.NextPage
+		if next == 0 {
+			break
+		}
+	}
+
+	return states, nil
+}
+
+func statusState(state string) CIState {
+	switch state {
+	case "success":
+		return CISuccess
+	case "pending":
+		return CIPending
+	default:
+		// failure and error
+		return CIFailure
+	}
+}
+
+func checkRunState(run *github.CheckRun) CIState {
+	if run.GetStatus() != "completed" {
+		return CIPending
+	}
+
+	for _, conclusion := range failingConclusions {
+		if run.GetConclusion() == conclusion {
+			return CIFailure
+		}
+	}
+
+	return CISuccess
+}
+
+func combineStates(states []CIState) CIState {
+	combined := CINone
+	for _, state := range states {
+		switch {
+		case state == CIFailure:
+			return CIFailure
+		case state == CIPending:
+			combined = CIPending
+		case combined == CINone:
+			combined = state
+		}
+	}
+
+	return combined
+}
+
+// checkCI checks the CI of the target of release according to gate, returns a warning when CI isn't passing and gate
+// is CIGateWarn and an error when gate is CIGateRefuse.
+func (gh *Client) checkCI(ctx context.Context, release *RepositoryRelease, gate CIGate) (string, error) {
+	if gate == "" || gate == CIGateOff {
+		return "", nil
+	}
+
+	state, err := gh.CI(ctx, release.Owner, release.Name, release.TargetSHA)
+	if err != nil {
+		return "", err
+	}
+
+	if state != CIFailure && state != CIPending {
+		return "", nil
+	}
+
+	message := fmt.Sprintf("CI is %s on %s", state, release.TargetSHA)
+	if gate == CIGateRefuse {
+		return "", fmt.Errorf("refusing to release, %s", message)
+	}
+
+	return message, nil
+}
@@ -0,0 +1,151 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCI(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   string
+		checks   string
+		expected CIState
+	}{
+		{
+			name:     "Nothing",
+			status:   `{"state": "pending", "total_count": 0}`,
+			checks:   `{"total_count": 0, "check_runs": []}`,
+			expected: CINone,
+		},
+		{
+			name:     "Statuses succeeded",
+			status:   `{"state": "success", "total_count": 2}`,
+			checks:   `{"total_count": 0, "check_runs": []}`,
+			expected: CISuccess,
+		},
+		{
+			name:     "Status errored",
+			status:   `{"state": "error", "total_count": 1}`,
+			checks:   `{"total_count": 0, "check_runs": []}`,
+			expected: CIFailure,
+		},
+		{
+			name:     "Checks succeeded",
+			status:   `{"state": "pending", "total_count": 0}`,
+			checks:   `{"total_count": 2, "check_runs": [{"status": "completed", "conclusion": "success"}, {"status": "completed", "conclusion": "skipped"}]}`,
+			expected: CISuccess,
+		},
+		{
+			name:     "Check in progress",
+			status:   `{"state": "success", "total_count": 1}`,
+			checks:   `{"total_count": 2, "check_runs": [{"status": "completed", "conclusion": "success"}, {"status": "in_progress"}]}`,
+			expected: CIPending,
+		},
+		{
+			name:     "Check failed",
+			status:   `{"state": "pending", "total_count": 1}`,
+			checks:   `{"total_count": 2, "check_runs": [{"status": "in_progress"}, {"status": "completed", "conclusion": "timed_out"}]}`,
+			expected: CIFailure,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			gh := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/repos/example/example1/commits/c1/status":
+					_, _ = w.Write([]byte(test.status))
+				case "/repos/example/example1/commits/c1/check-runs":
+					_, _ = w.Write([]byte(test.checks))
+				default:
+					t.Errorf("unexpected request %s", r.URL.Path)
+				}
+			})
+
+			state, err := gh.CI(context.Background(), "example", "example1", "c1")
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, state)
+		})
+	}
+}
+
+func TestCIGateFromString(t *testing.T) {
+	tests := []struct {
+		gate     string
+		expected CIGate
+		err      bool
+	}{
+		{gate: "", expected: CIGateOff},
+		{gate: "off", expected: CIGateOff},
+		{gate: "warn", expected: CIGateWarn},
+		{gate: "refuse", expected: CIGateRefuse},
+		{gate: "block", err: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.gate, func(t *testing.T) {
+			gate, err := CIGateFromString(test.gate)
+			if test.err {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, gate)
+		})
+	}
+}
+
+func TestCreateReleasesCI(t *testing.T) {
+	tests := []struct {
+		name    string
+		gate    CIGate
+		warning string
+		err     bool
+	}{
+		{name: "Off", gate: CIGateOff},
+		{name: "Warn", gate: CIGateWarn, warning: "CI is failure on c1"},
+		{name: "Refuse", gate: CIGateRefuse, err: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			created := false
+
+			gh := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/repos/example/example1/commits/c1/status":
+					_, _ = w.Write([]byte(`{"state": "failure", "total_count": 1}`))
+				case "/repos/example/example1/commits/c1/check-runs":
+					_, _ = w.Write([]byte(`{"total_count": 0, "check_runs": []}`))
+				case "/repos/example/example1/git/ref/tags/v1.1.0":
+					w.WriteHeader(http.StatusNotFound)
+				case "/repos/example/example1/releases":
+					if r.Method == http.MethodGet {
+						_, _ = w.Write([]byte(`[]`))
+						return
+					}
+
+					created = true
+					_, _ = w.Write([]byte(`{"id": 1, "html_url": "https://github.com/example/example1/releases/v1.1.0"}`))
+				default:
+					t.Errorf("unexpected request %s", r.URL.Path)
+				}
+			})
+
+			release := &RepositoryRelease{Owner: "example", Name: "example1", Version: "v1.1.0", TargetSHA: "c1", Branch: "main"}
+
+			responses := gh.CreateReleases(context.Background(), []*RepositoryRelease{release}, CreateOptions{Journal: &Journal{}, CI: test.gate})
+			require.Len(t, responses, 1)
+
+			assert.Equal(t, test.err, responses[0].IsError())
+			assert.Equal(t, !test.err, created)
+			assert.Equal(t, test.warning, responses[0].Warning)
+		})
+	}
+}
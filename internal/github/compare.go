@@ -0,0 +1,157 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/google/go-github/v41/github"
+)
+
+const (
+	// maxBaselineCandidates highest tags compared to the branch to find the latest tag of the branch.
+	maxBaselineCandidates = 5
+	// maxUntaggedCommits commits walked for branches without a tag, e.g. repositories that have never been tagged.
+	maxUntaggedCommits = 500
+
+	statusAhead     = "ahead"
+	statusIdentical = "identical"
+)
+
+// changes of a branch since its latest tag.
+type changes struct {
+	branch  string
+	tag     *github.RepositoryTag
+	commits []*github.RepositoryCommit
+
+	aheadBy      int
+	filesChanged int
+	additions    int
+	deletions    int
+}
+
+// changesSinceLatestTag compares the highest tags to branch to find the latest tag of branch and the changes since,
+// when none of them are an ancestor of branch at most maxUntaggedCommits of history are walked to find a tag.
+//
+// If branch is not provided or not found the default branch is used.
+func (gh *Client) changesSinceLatestTag(ctx context.Context, owner string, repo *github.Repository, tags []*github.RepositoryTag, branch string) (*changes, error) {
+	name := repo.GetName()
+
+	head := branch
+	if head == "" {
+		head = repo.GetDefaultBranch()
+	}
+
+	for _, tag := range gh.baselineCandidates(name, tags) {
+		result, err := gh.compare(ctx, owner, name, tag, head)
+		if err == errBranchNotFound && head != repo.GetDefaultBranch() {
+			head = repo.GetDefaultBranch()
+			result, err = gh.compare(ctx, owner, name, tag, head)
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if result != nil {
+			return result, nil
+		}
+	}
+
+	branch, tag, commits, err := gh.mostRecentTagAndChanges(ctx, owner, name, tags, head, maxUntaggedCommits)
+	if err != nil {
+		return nil, err
+	}
+
+	if branch == "" {
+		branch = repo.GetDefaultBranch()
+	}
+
+	walked := &changes{branch: branch, tag: tag, commits: commits, aheadBy: len(commits)}
+	if tag == nil || len(commits) == 0 {
+		return walked, nil
+	}
+
+	// An older tag is the latest tag of the branch
+	result, err := gh.compare(ctx, owner, name, tag, branch)
+	if err != nil || result == nil {
+		return walked, err
+	}
+
+	return result, nil
+}
+
+// baselineCandidates the highest maxBaselineCandidates tags of repo, highest first.
+func (gh *Client) baselineCandidates(repo string, tags []*github.RepositoryTag) []*github.RepositoryTag {
+	candidates := make([]*github.RepositoryTag, len(tags))
+	copy(candidates, tags)
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return gh.tagCompare(repo, candidates[i].GetName(), candidates[j].GetName()) > 0
+	})
+
+	if len(candidates) > maxBaselineCandidates {
+		candidates = candidates[:maxBaselineCandidates]
+	}
+
+	return candidates
+}
+
+var errBranchNotFound = errors.New("branch not found")
+
+// compare the changes of branch since tag, nil when tag isn't an ancestor of branch.
+func (gh *Client) compare(ctx context.Context, owner, repo string, tag *github.RepositoryTag, branch string) (*changes, error) {
+	result := &changes{branch: branch, tag: tag}
+
+	next := 1
+	for {
+		options := &github.ListOptions{Page: next, PerPage: githubMaxPerPage}
+
+		comparison, r, err := gh.client.Repositories.CompareCommits(ctx, owner, repo, tag.GetCommit().GetSHA(), branch, options)
+		if r != nil && r.StatusCode == http.StatusNotFound {
+			return nil, errBranchNotFound
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to compare %s to %s: %v", tag.GetName(), branch, err)
+		}
+
+		if err := github.CheckResponse(r.Response); err != nil {
+			return nil, fmt.Errorf("failed to compare %s to %s: %v", tag.GetName(), branch, err)
+		}
+
+		switch comparison.GetStatus() {
+		case statusAhead:
+		case statusIdentical:
+			return result, nil
+		default:
+			// Behind or diverged, tag isn't on the branch
+			return nil, nil
+		}
+
+		if next == 1 {
+			result.aheadBy = comparison.GetAheadBy()
+			result.filesChanged = len(comparison.Files)
+
+			for _, file := range comparison.Files {
+				result.additions += file.GetAdditions()
+				result.deletions += file.GetDeletions()
+			}
+		}
+
+		result.commits = append(result.commits, comparison.Commits...)
+
+		next = r.NextPage
+		if next == 0 || len(comparison.Commits) == 0 {
+			break
+		}
+	}
+
+	// Compared commits are oldest first, the most recent commit is first everywhere else
+	for i, j := 0, len(result.commits)-1; i < j; i, j = i+1, j-1 {
+		result.commits[i], result.commits[j] = result.commits[j], result.commits[i]
+	}
+
+	return result, nil
+}
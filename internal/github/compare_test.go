@@ -0,0 +1,120 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v41/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChangesSinceLatestTag(t *testing.T) {
+	repo := &github.Repository{Name: github.String("example1"), DefaultBranch: github.String("main")}
+
+	tests := []struct {
+		name     string
+		tags     []*github.RepositoryTag
+		branch   string
+		handler  http.HandlerFunc
+		tag      string
+		commits  []string
+		aheadBy  int
+		files    int
+		branchTo string
+	}{
+		{
+			name: "Highest tag on the branch",
+			tags: []*github.RepositoryTag{
+				{Name: github.String("v1.0.0"), Commit: &github.Commit{SHA: github.String("c1")}},
+				{Name: github.String("v2.0.0"), Commit: &github.Commit{SHA: github.String("other")}},
+				{Name: github.String("v1.1.0"), Commit: &github.Commit{SHA: github.String("c2")}},
+			},
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/repos/example/example1/compare/other...main":
+					_, _ = w.Write([]byte(`{"status": "diverged"}`))
+				case "/repos/example/example1/compare/c2...main":
+					_, _ = w.Write([]byte(`{"status": "ahead", "ahead_by": 2, "commits": [{"sha": "c3"}, {"sha": "c4"}], "files": [{"additions": 1}]}`))
+				default:
+					t.Errorf("unexpected request %s", r.URL.Path)
+				}
+			},
+			tag:      "v1.1.0",
+			commits:  []string{"c4", "c3"},
+			aheadBy:  2,
+			files:    1,
+			branchTo: "main",
+		},
+		{
+			name: "Branch not found uses the default branch",
+			tags: []*github.RepositoryTag{
+				{Name: github.String("v1.0.0"), Commit: &github.Commit{SHA: github.String("c1")}},
+			},
+			branch: "missing",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/repos/example/example1/compare/c1...missing":
+					w.WriteHeader(http.StatusNotFound)
+				case "/repos/example/example1/compare/c1...main":
+					_, _ = w.Write([]byte(`{"status": "identical"}`))
+				default:
+					t.Errorf("unexpected request %s", r.URL.Path)
+				}
+			},
+			tag:      "v1.0.0",
+			branchTo: "main",
+		},
+		{
+			name: "Untagged history is capped",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/repos/example/example1/commits" {
+					t.Errorf("unexpected request %s", r.URL.Path)
+					return
+				}
+
+				page := r.URL.Query().Get("page")
+
+				// Infinite history
+				w.Header().Set("Link", fmt.Sprintf(`<%s?page=%s0>; rel="next"`, r.URL.Path, page))
+				commits := "["
+				for i := 0; i < githubMaxPerPage; i++ {
+					if i > 0 {
+						commits += ","
+					}
+
+					commits += fmt.Sprintf(`{"sha": "%s-%d"}`, page, i)
+				}
+
+				_, _ = w.Write([]byte(commits + "]"))
+			},
+			aheadBy:  maxUntaggedCommits,
+			branchTo: "main",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			gh := newTestClient(t, test.handler)
+
+			changes, err := gh.changesSinceLatestTag(context.Background(), "example", repo, test.tags, test.branch)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.tag, changes.tag.GetName())
+			assert.Equal(t, test.branchTo, changes.branch)
+			assert.Equal(t, test.aheadBy, changes.aheadBy)
+			assert.Equal(t, test.files, changes.filesChanged)
+
+			if test.commits != nil {
+				var shas []string
+				for _, c := range changes.commits {
+					shas = append(shas, c.GetSHA())
+				}
+
+				assert.Equal(t, test.commits, shas)
+			}
+		})
+	}
+}
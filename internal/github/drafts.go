@@ -0,0 +1,189 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/google/go-github/v41/github"
+	"golang.org/x/sync/errgroup"
+)
+
+// DraftRelease a draft release of a repository that has yet to be published.
+type DraftRelease struct {
+	Owner   string
+	Repo    string
+	Release *github.RepositoryRelease
+}
+
+// DraftReleases all draft releases of the repositories of owner, when user is true owner is the authenticated user
+// instead of an organization.
+func (gh *Client) DraftReleases(ctx context.Context, owner string, user bool) ([]*DraftRelease, error) {
+	repos, err := gh.repos(ctx, owner, user)
+	if err != nil {
+		return nil, err
+	}
+
+	var mu sync.Mutex
+	var drafts []*DraftRelease
+
+	errGrp, ctx := errgroup.WithContext(ctx)
+
+	for _, repo := range repos {
+		repo := repo
+
+		errGrp.Go(func() error {
+			var releases []*github.RepositoryRelease
+
+			err := gh.reads.Do(ctx, func() (err error) {
+				releases, err = gh.drafts(ctx, owner, repo.GetName())
+				return err
+			})
+			if err != nil {
+				return err
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			for _, release := range releases {
+				drafts = append(drafts, &DraftRelease{Owner: owner, Repo: repo.GetName(), Release: release})
+			}
+
+			return nil
+		})
+	}
+
+	if err := errGrp.Wait(); err != nil {
+		return nil, err
+	}
+
+	return drafts, nil
+}
+
+// PublishDrafts publishes the draft releases, the response includes an error for every release that failed to publish.
+func (gh *Client) PublishDrafts(ctx context.Context, drafts []*DraftRelease) []*RepositoryReleaseResponse {
+	c := make(chan *RepositoryReleaseResponse, len(drafts))
+
+	var wg sync.WaitGroup
+	wg.Add(len(drafts))
+
+	for _, draft := range drafts {
+		draft := draft
+
+		go func() {
+			defer wg.Done()
+
+			response := &RepositoryReleaseResponse{Owner: draft.Owner, Name: draft.Repo, Body: draft.Release.GetBody(), Version: draft.Release.GetTagName()}
+
+			var release *github.RepositoryRelease
+			err := gh.writes.Do(ctx, func() (err error) {
+				release, err = gh.publishDraft(ctx, draft)
+				return err
+			})
+			if err != nil {
+				response.Error = err
+			} else {
+				response.URL = release.GetHTMLURL()
+			}
+
+			c <- response
+		}()
+	}
+
+	wg.Wait()
+	close(c)
+
+	var responses []*RepositoryReleaseResponse
+	for response := range c {
+		responses = append(responses, response)
+	}
+
+	return responses
+}
+
+func (gh *Client) publishDraft(ctx context.Context, draft *DraftRelease) (*github.RepositoryRelease, error) {
+	release, r, err := gh.client.Repositories.EditRelease(ctx, draft.Owner, draft.Repo, draft.Release.GetID(), &github.RepositoryRelease{Draft: github.Bool(false)})
+	if err != nil {
+		return nil, fmt.Errorf("failed to publish draft %s: %v", draft.Release.GetTagName(), err)
+	}
+
+	if err := github.CheckResponse(r.Response); err != nil {
+		return nil, fmt.Errorf("failed to publish draft %s: %v", draft.Release.GetTagName(), err)
+	}
+
+	return release, nil
+}
+
+// drafts the draft releases of a repository.
+func (gh *Client) drafts(ctx context.Context, owner, repo string) ([]*github.RepositoryRelease, error) {
+	next := 1
+
+	var drafts []*github.RepositoryRelease
+	for {
+		options := &github.ListOptions{Page: next, PerPage: githubMaxPerPage}
+
+		releases, r, err := gh.client.Repositories.ListReleases(ctx, owner, repo, options)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list releases for %s/%s: %v", owner, repo, err)
+		}
+
+		if err := github.CheckResponse(r.Response); err != nil {
+			return nil, fmt.Errorf("failed to list releases for %s/%s: %v", owner, repo, err)
+		}
+
+		for _, release := range releases {
+			if release.GetDraft() {
+				drafts = append(drafts, release)
+			}
+		}
+
+		next = r.NextPage
+		if next == 0 {
+			break
+		}
+	}
+
+	return drafts, nil
+}
+
+// repos the repositories of owner that aren't archived, when user is true owner is the authenticated user.
+func (gh *Client) repos(ctx context.Context, owner string, user bool) ([]*github.Repository, error) {
+	next := 1
+
+	var repos []*github.Repository
+	for {
+		listOptions := github.ListOptions{Page: next, PerPage: githubMaxPerPage}
+
+		var page []*github.Repository
+		var r *github.Response
+		var err error
+
+		if user {
+			page, r, err = gh.client.Repositories.List(ctx, "", &github.RepositoryListOptions{Affiliation: "owner", ListOptions: listOptions})
+		} else {
+			page, r, err = gh.client.Repositories.ListByOrg(ctx, owner, &github.RepositoryListByOrgOptions{ListOptions: listOptions})
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to list repositories for %s: %v", owner, err)
+		}
+
+		if err := github.CheckResponse(r.Response); err != nil {
+			return nil, fmt.Errorf("failed to list repositories for %s: %v", owner, err)
+		}
+
+		for _, repo := range page {
+			if !repo.GetArchived() {
+				repos = append(repos, repo)
+			}
+		}
+
+		next = r.NextPage
+		if next == 0 {
+			break
+		}
+	}
+
+	return repos, nil
+}
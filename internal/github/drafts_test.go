@@ -0,0 +1,162 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v41/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDraftReleases(t *testing.T) {
+	tests := []struct {
+		name  string
+		user  bool
+		repos string
+	}{
+		{name: "Organization", repos: "/orgs/example/repos"},
+		{name: "User", user: true, repos: "/user/repos"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			gh := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case test.repos:
+					_, _ = w.Write([]byte(`[{"name": "example1"}, {"name": "archived", "archived": true}, {"name": "example2"}]`))
+				case "/repos/example/example1/releases":
+					_, _ = w.Write([]byte(`[{"id": 2, "tag_name": "v1.1.0", "draft": true}, {"id": 1, "tag_name": "v1.0.0", "draft": false}]`))
+				case "/repos/example/example2/releases":
+					_, _ = w.Write([]byte(`[]`))
+				default:
+					t.Errorf("unexpected request %s", r.URL.Path)
+				}
+			})
+
+			drafts, err := gh.DraftReleases(context.Background(), "example", test.user)
+			require.NoError(t, err)
+			require.Len(t, drafts, 1)
+
+			assert.Equal(t, "example", drafts[0].Owner)
+			assert.Equal(t, "example1", drafts[0].Repo)
+			assert.Equal(t, "v1.1.0", drafts[0].Release.GetTagName())
+		})
+	}
+}
+
+func TestDraftReleasesError(t *testing.T) {
+	gh := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/orgs/example/repos":
+			_, _ = w.Write([]byte(`[{"name": "example1"}]`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	_, err := gh.DraftReleases(context.Background(), "example", false)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "example/example1")
+}
+
+func TestPublishDrafts(t *testing.T) {
+	gh := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]interface{}{"draft": false}, body)
+
+		switch r.URL.Path {
+		case "/repos/example/example1/releases/1":
+			_, _ = w.Write([]byte(`{"id": 1, "html_url": "https://github.com/example/example1/releases/v1.1.0"}`))
+		case "/repos/example/example2/releases/2":
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			_, _ = w.Write([]byte(`{"message": "Validation Failed"}`))
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+	})
+
+	drafts := []*DraftRelease{
+		{Owner: "example", Repo: "example1", Release: &github.RepositoryRelease{ID: github.Int64(1), TagName: github.String("v1.1.0")}},
+		{Owner: "example", Repo: "example2", Release: &github.RepositoryRelease{ID: github.Int64(2), TagName: github.String("v2.0.0")}},
+	}
+
+	responses := gh.PublishDrafts(context.Background(), drafts)
+	require.Len(t, responses, 2)
+
+	byName := make(map[string]*RepositoryReleaseResponse)
+	for _, response := range responses {
+		byName[response.Name] = response
+	}
+
+	require.NoError(t, byName["example1"].Error)
+	assert.Equal(t, "https://github.com/example/example1/releases/v1.1.0", byName["example1"].URL)
+	assert.Equal(t, "v1.1.0", byName["example1"].Version)
+
+	require.Error(t, byName["example2"].Error)
+	assert.Contains(t, byName["example2"].Error.Error(), "v2.0.0")
+	assert.Empty(t, byName["example2"].URL)
+}
+
+func TestDraftedVersion(t *testing.T) {
+	tests := []struct {
+		name    string
+		drafted bool
+	}{
+		{name: "Drafted", drafted: true},
+		{name: "Not drafted"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			created := false
+
+			gh := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.URL.Path == "/repos/example/example1/branches/main":
+					_, _ = w.Write([]byte(`{"name": "main", "commit": {"sha": "c1"}}`))
+				case r.URL.Path == "/repos/example/example1/git/ref/tags/v1.1.0":
+					w.WriteHeader(http.StatusNotFound)
+				case r.URL.Path == "/repos/example/example1/releases" && r.Method == http.MethodGet:
+					if test.drafted {
+						_, _ = w.Write([]byte(`[{"id": 2, "tag_name": "v1.1.0", "draft": true}]`))
+						return
+					}
+
+					_, _ = w.Write([]byte(`[{"id": 1, "tag_name": "v1.0.0", "draft": false}]`))
+				case r.URL.Path == "/repos/example/example1/releases" && r.Method == http.MethodPost:
+					created = true
+					_, _ = w.Write([]byte(`{"id": 3}`))
+				default:
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				}
+			})
+
+			release := &RepositoryRelease{Owner: "example", Name: "example1", Version: "v1.1.0", TargetSHA: "c1", Branch: "main", Draft: true}
+
+			errs := gh.VerifyReleases(context.Background(), []*RepositoryRelease{release})
+
+			_, err := gh.createRelease(context.Background(), "example", release, false, &Journal{})
+
+			if test.drafted {
+				require.Len(t, errs, 1)
+				assert.Contains(t, errs[0].Error(), "draft release v1.1.0 already exists")
+
+				require.Error(t, err)
+				assert.False(t, created)
+				return
+			}
+
+			assert.Empty(t, errs)
+			require.NoError(t, err)
+			assert.True(t, created)
+		})
+	}
+}
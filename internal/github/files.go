@@ -0,0 +1,225 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/NickHackman/releaser/internal/version"
+	"github.com/google/go-github/v41/github"
+)
+
+const (
+	fileMode        = "100644"
+	blobType        = "blob"
+	versionFilesMsg = "Release %s"
+)
+
+// VersionFile a file in a repository that contains the version, updated prior to creating a release.
+//
+// When neither Key or Pattern are provided the whole file is replaced by the version.
+type VersionFile struct {
+	// Path to the file relative to the root of the repository.
+	Path string `yaml:"path" json:"path"`
+	// Key top level key for JSON and YAML files (version), or a key prefixed by its table for TOML files (tool.poetry.version).
+	Key string `yaml:"key,omitempty" json:"key,omitempty"`
+	// Pattern regular expression where the first capture group is replaced by the version `Version = "(.*)"`.
+	Pattern string `yaml:"pattern,omitempty" json:"pattern,omitempty"`
+}
+
+// Replace the version in content of the file.
+func (vf VersionFile) Replace(content []byte, newVersion string) ([]byte, error) {
+	switch {
+	case vf.Pattern != "":
+		pattern, err := vf.compile()
+		if err != nil {
+			return nil, err
+		}
+
+		return replaceFirstGroup(pattern, content, newVersion, vf.Path)
+	case vf.Key != "":
+		return vf.replaceKey(content, newVersion)
+	default:
+		return []byte(newVersion + "\n"), nil
+	}
+}
+
+// Validate the VersionFile has a path and a valid pattern.
+func (vf VersionFile) Validate() error {
+	if vf.Path == "" {
+		return errors.New("version file is missing a path")
+	}
+
+	if vf.Pattern != "" {
+		_, err := vf.compile()
+		return err
+	}
+
+	return nil
+}
+
+func (vf VersionFile) compile() (*regexp.Regexp, error) {
+	pattern, err := regexp.Compile(vf.Pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pattern '%s' for %s: %v", vf.Pattern, vf.Path, err)
+	}
+
+	if pattern.NumSubexp() == 0 {
+		return nil, fmt.Errorf("invalid pattern '%s' for %s: expected a capture group for the version", vf.Pattern, vf.Path)
+	}
+
+	return pattern, nil
+}
+
+func (vf VersionFile) replaceKey(content []byte, newVersion string) ([]byte, error) {
+	key := regexp.QuoteMeta(vf.Key)
+
+	switch strings.ToLower(path.Ext(vf.Path)) {
+	case ".json":
+		return replaceFirstGroup(regexp.MustCompile(`"`+key+`"\s*:\s*"([^"]*)"`), content, newVersion, vf.Path)
+	case ".yaml", ".yml":
+		return replaceFirstGroup(regexp.MustCompile(`(?m)^`+key+`:[ \t]*["']?([^"'\s#]*)`), content, newVersion, vf.Path)
+	case ".toml":
+		return replaceTOMLKey(content, vf.Key, newVersion, vf.Path)
+	default:
+		return nil, fmt.Errorf("key is unsupported for %s expected a JSON, YAML or TOML file", vf.Path)
+	}
+}
+
+// replaceTOMLKey replaces the value of key within its table, tool.poetry.version is version in [tool.poetry].
+func replaceTOMLKey(content []byte, key, newVersion, filename string) ([]byte, error) {
+	table, name := "", key
+	if i := strings.LastIndex(key, "."); i != -1 {
+		table, name = key[:i], key[i+1:]
+	}
+
+	start, end := 0, len(content)
+
+	if table != "" {
+		header := regexp.MustCompile(`(?m)^\[` + regexp.QuoteMeta(table) + `\][ \t]*$`)
+
+		loc := header.FindIndex(content)
+		if loc == nil {
+			return nil, fmt.Errorf("failed to find table [%s] in %s", table, filename)
+		}
+
+		start = loc[1]
+	}
+
+	// The table ends at the next table
+	if next := regexp.MustCompile(`(?m)^\[`).FindIndex(content[start:]); next != nil {
+		end = start + next[0]
+	}
+
+	pattern := regexp.MustCompile(`(?m)^` + regexp.QuoteMeta(name) + `[ \t]*=[ \t]*"([^"]*)"`)
+
+	replaced, err := replaceFirstGroup(pattern, content[start:end], newVersion, filename)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]byte, 0, len(content)+len(newVersion))
+	result = append(result, content[:start]...)
+	result = append(result, replaced...)
+	return append(result, content[end:]...), nil
+}
+
+// replaceFirstGroup replaces the first capture group of the first match of pattern in content.
+func replaceFirstGroup(pattern *regexp.Regexp, content []byte, newVersion, filename string) ([]byte, error) {
+	loc := pattern.FindSubmatchIndex(content)
+	if loc == nil || len(loc) < 4 || loc[2] == -1 {
+		return nil, fmt.Errorf("failed to find version in %s using '%s'", filename, pattern)
+	}
+
+	result := make([]byte, 0, len(content)+len(newVersion))
+	result = append(result, content[:loc[2]]...)
+	result = append(result, newVersion...)
+	return append(result, content[loc[3]:]...), nil
+}
+
+// commitVersionFiles commits the updated version files on top of the release's TargetSHA and fast forwards the branch
+// to the new commit. Returns the SHA of the new commit.
+func (gh *Client) commitVersionFiles(ctx context.Context, owner string, releaseInfo *RepositoryRelease) (string, error) {
+	newVersion := releaseInfo.Version
+	if _, v, ok := version.ParseTag(releaseInfo.Version); ok {
+		newVersion = v
+	}
+
+	var entries []*github.TreeEntry
+	for _, file := range releaseInfo.Files {
+		fileContent, _, r, err := gh.client.Repositories.GetContents(ctx, owner, releaseInfo.Name, file.Path, &github.RepositoryContentGetOptions{Ref: releaseInfo.TargetSHA})
+		if err != nil {
+			return "", fmt.Errorf("failed to get %s: %v", file.Path, err)
+		}
+
+		if err := github.CheckResponse(r.Response); err != nil {
+			return "", fmt.Errorf("failed to get %s: %v", file.Path, err)
+		}
+
+		content, err := fileContent.GetContent()
+		if err != nil {
+			return "", fmt.Errorf("failed to decode %s: %v", file.Path, err)
+		}
+
+		replaced, err := file.Replace([]byte(content), newVersion)
+		if err != nil {
+			return "", err
+		}
+
+		entries = append(entries, &github.TreeEntry{
+			Path:    github.String(file.Path),
+			Mode:    github.String(fileMode),
+			Type:    github.String(blobType),
+			Content: github.String(string(replaced)),
+		})
+	}
+
+	parent, r, err := gh.client.Git.GetCommit(ctx, owner, releaseInfo.Name, releaseInfo.TargetSHA)
+	if err != nil {
+		return "", fmt.Errorf("failed to get commit %s: %v", releaseInfo.TargetSHA, err)
+	}
+
+	if err := github.CheckResponse(r.Response); err != nil {
+		return "", fmt.Errorf("failed to get commit %s: %v", releaseInfo.TargetSHA, err)
+	}
+
+	tree, r, err := gh.client.Git.CreateTree(ctx, owner, releaseInfo.Name, parent.GetTree().GetSHA(), entries)
+	if err != nil {
+		return "", fmt.Errorf("failed to create tree: %v", err)
+	}
+
+	if err := github.CheckResponse(r.Response); err != nil {
+		return "", fmt.Errorf("failed to create tree: %v", err)
+	}
+
+	commit := &github.Commit{
+		Message: github.String(fmt.Sprintf(versionFilesMsg, releaseInfo.Version)),
+		Tree:    tree,
+		Parents: []*github.Commit{{SHA: github.String(releaseInfo.TargetSHA)}},
+	}
+
+	created, r, err := gh.client.Git.CreateCommit(ctx, owner, releaseInfo.Name, commit)
+	if err != nil {
+		return "", fmt.Errorf("failed to create commit: %v", err)
+	}
+
+	if err := github.CheckResponse(r.Response); err != nil {
+		return "", fmt.Errorf("failed to create commit: %v", err)
+	}
+
+	// Not forced, fails when the branch has moved past TargetSHA
+	ref := &github.Reference{Ref: github.String("refs/heads/" + releaseInfo.Branch), Object: &github.GitObject{SHA: created.SHA}}
+	_, r, err = gh.client.Git.UpdateRef(ctx, owner, releaseInfo.Name, ref, false)
+	if err != nil {
+		return "", fmt.Errorf("failed to update branch %s: %v", releaseInfo.Branch, err)
+	}
+
+	if err := github.CheckResponse(r.Response); err != nil {
+		return "", fmt.Errorf("failed to update branch %s: %v", releaseInfo.Branch, err)
+	}
+
+	return created.GetSHA(), nil
+}
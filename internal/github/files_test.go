@@ -0,0 +1,208 @@
+package github
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVersionFileReplace(t *testing.T) {
+	tests := []struct {
+		name     string
+		file     VersionFile
+		content  string
+		expected string
+		isErr    bool
+	}{
+		{
+			name:     "whole file",
+			file:     VersionFile{Path: "VERSION"},
+			content:  "1.2.3\n",
+			expected: "1.3.0\n",
+		},
+		{
+			name:     "pattern",
+			file:     VersionFile{Path: "version.go", Pattern: `Version = "(.*)"`},
+			content:  "package version\n\nconst Version = \"1.2.3\"\n",
+			expected: "package version\n\nconst Version = \"1.3.0\"\n",
+		},
+		{
+			name:    "pattern not found",
+			file:    VersionFile{Path: "version.go", Pattern: `Version = "(.*)"`},
+			content: "package version\n",
+			isErr:   true,
+		},
+		{
+			name:     "json",
+			file:     VersionFile{Path: "package.json", Key: "version"},
+			content:  "{\n  \"name\": \"example\",\n  \"version\": \"1.2.3\",\n  \"dependencies\": {\"a\": \"1.0.0\"}\n}\n",
+			expected: "{\n  \"name\": \"example\",\n  \"version\": \"1.3.0\",\n  \"dependencies\": {\"a\": \"1.0.0\"}\n}\n",
+		},
+		{
+			name:     "yaml",
+			file:     VersionFile{Path: "Chart.yaml", Key: "appVersion"},
+			content:  "apiVersion: v2\nversion: 0.1.0\nappVersion: \"1.2.3\" # app\n",
+			expected: "apiVersion: v2\nversion: 0.1.0\nappVersion: \"1.3.0\" # app\n",
+		},
+		{
+			name:     "toml table",
+			file:     VersionFile{Path: "pyproject.toml", Key: "tool.poetry.version"},
+			content:  "[build-system]\nversion = \"0.0.1\"\n\n[tool.poetry]\nname = \"example\"\nversion = \"1.2.3\"\n\n[tool.black]\n",
+			expected: "[build-system]\nversion = \"0.0.1\"\n\n[tool.poetry]\nname = \"example\"\nversion = \"1.3.0\"\n\n[tool.black]\n",
+		},
+		{
+			name:    "toml missing table",
+			file:    VersionFile{Path: "pyproject.toml", Key: "project.version"},
+			content: "[tool.poetry]\nversion = \"1.2.3\"\n",
+			isErr:   true,
+		},
+		{
+			name:    "unsupported key",
+			file:    VersionFile{Path: "VERSION", Key: "version"},
+			content: "1.2.3\n",
+			isErr:   true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			replaced, err := test.file.Replace([]byte(test.content), "1.3.0")
+			if test.isErr {
+				assert.NotNil(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, string(replaced))
+		})
+	}
+}
+
+func TestVersionFileValidate(t *testing.T) {
+	assert.NoError(t, VersionFile{Path: "VERSION"}.Validate())
+	assert.NotNil(t, VersionFile{}.Validate())
+	assert.NotNil(t, VersionFile{Path: "version.go", Pattern: "("}.Validate())
+	assert.NotNil(t, VersionFile{Path: "version.go", Pattern: "Version"}.Validate())
+}
+
+func TestCreateReleaseVersionFiles(t *testing.T) {
+	content := base64.StdEncoding.EncodeToString([]byte("{\n  \"version\": \"1.0.0\"\n}\n"))
+
+	var writes []string
+
+	gh := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		if r.Method != http.MethodGet {
+			writes = append(writes, r.Method+" "+r.URL.Path)
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		}
+
+		switch r.Method + " " + r.URL.Path {
+		case "GET /repos/example/example1/releases":
+			_, _ = w.Write([]byte(`[]`))
+		case "GET /repos/example/example1/contents/package.json":
+			assert.Equal(t, "c1", r.URL.Query().Get("ref"))
+			_, _ = w.Write([]byte(`{"type": "file", "encoding": "base64", "path": "package.json", "content": "` + content + `"}`))
+		case "GET /repos/example/example1/git/commits/c1":
+			_, _ = w.Write([]byte(`{"sha": "c1", "tree": {"sha": "t1"}}`))
+		case "POST /repos/example/example1/git/trees":
+			assert.Equal(t, "t1", body["base_tree"])
+			assert.Equal(t, []interface{}{map[string]interface{}{
+				"path":    "package.json",
+				"mode":    fileMode,
+				"type":    blobType,
+				"content": "{\n  \"version\": \"1.1.0\"\n}\n",
+			}}, body["tree"])
+
+			_, _ = w.Write([]byte(`{"sha": "t2"}`))
+		case "POST /repos/example/example1/git/commits":
+			assert.Equal(t, "Release v1.1.0", body["message"])
+			assert.Equal(t, "t2", body["tree"])
+			assert.Equal(t, []interface{}{"c1"}, body["parents"])
+
+			_, _ = w.Write([]byte(`{"sha": "c2"}`))
+		case "PATCH /repos/example/example1/git/refs/heads/main":
+			assert.Equal(t, "c2", body["sha"])
+			assert.Equal(t, false, body["force"])
+
+			_, _ = w.Write([]byte(`{"ref": "refs/heads/main", "object": {"sha": "c2"}}`))
+		case "GET /repos/example/example1/git/ref/tags/v1.1.0":
+			w.WriteHeader(http.StatusNotFound)
+		case "POST /repos/example/example1/releases":
+			// The release and its tag are of the commit of version files
+			assert.Equal(t, "c2", body["target_commitish"])
+			assert.Equal(t, "v1.1.0", body["tag_name"])
+
+			_, _ = w.Write([]byte(`{"id": 1}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	journal := &Journal{}
+	release := &RepositoryRelease{
+		Owner:     "example",
+		Name:      "example1",
+		Version:   "v1.1.0",
+		TargetSHA: "c1",
+		Branch:    "main",
+		Files:     []VersionFile{{Path: "package.json", Key: "version"}},
+	}
+
+	_, err := gh.createRelease(context.Background(), "example", release, false, journal)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		"POST /repos/example/example1/git/trees",
+		"POST /repos/example/example1/git/commits",
+		"PATCH /repos/example/example1/git/refs/heads/main",
+		"POST /repos/example/example1/releases",
+	}, writes)
+
+	assert.Equal(t, []*Entry{
+		{Kind: CommitEntry, Owner: "example", Repo: "example1", Ref: "main", SHA: "c2", Parent: "c1"},
+		{Kind: TagEntry, Owner: "example", Repo: "example1", Ref: "v1.1.0"},
+		{Kind: ReleaseEntry, Owner: "example", Repo: "example1", ID: 1},
+	}, journal.Entries)
+}
+
+func TestCreateReleaseVersionFilesBranchMoved(t *testing.T) {
+	created := false
+
+	gh := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /repos/example/example1/releases":
+			_, _ = w.Write([]byte(`[]`))
+		case "GET /repos/example/example1/contents/VERSION":
+			_, _ = w.Write([]byte(`{"type": "file", "encoding": "base64", "path": "VERSION", "content": "MS4wLjAK"}`))
+		case "GET /repos/example/example1/git/commits/c1":
+			_, _ = w.Write([]byte(`{"sha": "c1", "tree": {"sha": "t1"}}`))
+		case "POST /repos/example/example1/git/trees":
+			_, _ = w.Write([]byte(`{"sha": "t2"}`))
+		case "POST /repos/example/example1/git/commits":
+			_, _ = w.Write([]byte(`{"sha": "c2"}`))
+		case "PATCH /repos/example/example1/git/refs/heads/main":
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			_, _ = w.Write([]byte(`{"message": "Update is not a fast forward"}`))
+		case "POST /repos/example/example1/releases":
+			created = true
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	journal := &Journal{}
+	release := &RepositoryRelease{Owner: "example", Name: "example1", Version: "v1.1.0", TargetSHA: "c1", Branch: "main", Files: []VersionFile{{Path: "VERSION"}}}
+
+	_, err := gh.createRelease(context.Background(), "example", release, false, journal)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to update branch main")
+
+	assert.False(t, created)
+	assert.Empty(t, journal.Entries)
+}
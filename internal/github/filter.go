@@ -0,0 +1,157 @@
+package github
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/google/go-github/v41/github"
+)
+
+// Forks how forked repositories are filtered.
+type Forks string
+
+const (
+	IncludeForks Forks = "include"
+	ExcludeForks Forks = "exclude"
+	OnlyForks    Forks = "only"
+)
+
+var visibilities = []string{"public", "private", "internal"}
+
+// RepositoryFilter selects the repositories considered for releases, empty fields don't filter.
+//
+// Names are glob patterns (service-*) or regular expressions between slashes (/^svc-[a-z]+$/).
+type RepositoryFilter struct {
+	Topics           []string
+	ExcludeTopics    []string
+	Languages        []string
+	ExcludeLanguages []string
+	Visibility       []string
+	Forks            Forks
+	Names            []string
+	ExcludeNames     []string
+}
+
+// Validate the visibilities, forks and name patterns of the filter.
+func (f *RepositoryFilter) Validate() error {
+	for _, visibility := range f.Visibility {
+		if !containsFold(visibilities, visibility) {
+			return fmt.Errorf("invalid visibility '%s' expected one of %s", visibility, strings.Join(visibilities, ", "))
+		}
+	}
+
+	switch f.Forks {
+	case "", IncludeForks, ExcludeForks, OnlyForks:
+	default:
+		return fmt.Errorf("invalid forks '%s' expected one of %s, %s, %s", f.Forks, IncludeForks, ExcludeForks, OnlyForks)
+	}
+
+	for _, pattern := range append(append([]string{}, f.Names...), f.ExcludeNames...) {
+		if _, err := matchName(pattern, ""); err != nil {
+			return fmt.Errorf("invalid name pattern '%s': %v", pattern, err)
+		}
+	}
+
+	return nil
+}
+
+// Matches whether repo is selected by the filter.
+func (f *RepositoryFilter) Matches(repo *github.Repository) bool {
+	switch f.Forks {
+	case ExcludeForks:
+		if repo.GetFork() {
+			return false
+		}
+	case OnlyForks:
+		if !repo.GetFork() {
+			return false
+		}
+	}
+
+	if len(f.Visibility) > 0 && !containsFold(f.Visibility, visibility(repo)) {
+		return false
+	}
+
+	language := repo.GetLanguage()
+	if len(f.Languages) > 0 && !containsFold(f.Languages, language) {
+		return false
+	}
+
+	if language != "" && containsFold(f.ExcludeLanguages, language) {
+		return false
+	}
+
+	if len(f.Topics) > 0 && !containsAnyFold(f.Topics, repo.Topics) {
+		return false
+	}
+
+	if containsAnyFold(f.ExcludeTopics, repo.Topics) {
+		return false
+	}
+
+	if len(f.Names) > 0 && !matchesAnyName(f.Names, repo.GetName()) {
+		return false
+	}
+
+	return !matchesAnyName(f.ExcludeNames, repo.GetName())
+}
+
+// visibility of repo, repositories listed without their visibility are either public or private.
+func visibility(repo *github.Repository) string {
+	if repo.GetVisibility() != "" {
+		return repo.GetVisibility()
+	}
+
+	if repo.GetPrivate() {
+		return "private"
+	}
+
+	return "public"
+}
+
+// matchName whether name matches a glob pattern, or a regular expression between slashes.
+func matchName(pattern, name string) (bool, error) {
+	if len(pattern) > 1 && strings.HasPrefix(pattern, "/") && strings.HasSuffix(pattern, "/") {
+		re, err := regexp.Compile(pattern[1 : len(pattern)-1])
+		if err != nil {
+			return false, err
+		}
+
+		return re.MatchString(name), nil
+	}
+
+	return path.Match(strings.ToLower(pattern), strings.ToLower(name))
+}
+
+func matchesAnyName(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		// Validated when loaded
+		if ok, _ := matchName(pattern, name); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+func containsFold(values []string, value string) bool {
+	for _, current := range values {
+		if strings.EqualFold(current, value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func containsAnyFold(values, candidates []string) bool {
+	for _, candidate := range candidates {
+		if containsFold(values, candidate) {
+			return true
+		}
+	}
+
+	return false
+}
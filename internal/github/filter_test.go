@@ -0,0 +1,59 @@
+package github
+
+import (
+	"testing"
+
+	"github.com/google/go-github/v41/github"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRepositoryFilterMatches(t *testing.T) {
+	service := &github.Repository{
+		Name:       github.String("service-a"),
+		Language:   github.String("Go"),
+		Topics:     []string{"service", "payments"},
+		Visibility: github.String("internal"),
+	}
+
+	experiment := &github.Repository{
+		Name:     github.String("experiment"),
+		Language: github.String("Python"),
+		Topics:   []string{"experiment"},
+		Private:  github.Bool(true),
+		Fork:     github.Bool(true),
+	}
+
+	tests := []struct {
+		name     string
+		filter   RepositoryFilter
+		expected []bool
+	}{
+		{name: "Empty", filter: RepositoryFilter{}, expected: []bool{true, true}},
+		{name: "Topics", filter: RepositoryFilter{Topics: []string{"Service"}}, expected: []bool{true, false}},
+		{name: "Exclude topics", filter: RepositoryFilter{ExcludeTopics: []string{"experiment"}}, expected: []bool{true, false}},
+		{name: "Languages", filter: RepositoryFilter{Languages: []string{"go"}}, expected: []bool{true, false}},
+		{name: "Exclude languages", filter: RepositoryFilter{ExcludeLanguages: []string{"go"}}, expected: []bool{false, true}},
+		{name: "Visibility", filter: RepositoryFilter{Visibility: []string{"private"}}, expected: []bool{false, true}},
+		{name: "Exclude forks", filter: RepositoryFilter{Forks: ExcludeForks}, expected: []bool{true, false}},
+		{name: "Only forks", filter: RepositoryFilter{Forks: OnlyForks}, expected: []bool{false, true}},
+		{name: "Name glob", filter: RepositoryFilter{Names: []string{"Service-*"}}, expected: []bool{true, false}},
+		{name: "Name regex", filter: RepositoryFilter{Names: []string{"/^exp/"}}, expected: []bool{false, true}},
+		{name: "Exclude names", filter: RepositoryFilter{ExcludeNames: []string{"service-*"}}, expected: []bool{false, true}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected[0], test.filter.Matches(service))
+			assert.Equal(t, test.expected[1], test.filter.Matches(experiment))
+		})
+	}
+}
+
+func TestRepositoryFilterValidate(t *testing.T) {
+	assert.NoError(t, (&RepositoryFilter{Visibility: []string{"Public"}, Forks: ExcludeForks, Names: []string{"service-*", "/^svc-/"}}).Validate())
+
+	assert.Error(t, (&RepositoryFilter{Visibility: []string{"secret"}}).Validate())
+	assert.Error(t, (&RepositoryFilter{Forks: "sometimes"}).Validate())
+	assert.Error(t, (&RepositoryFilter{Names: []string{"service-["}}).Validate())
+	assert.Error(t, (&RepositoryFilter{ExcludeNames: []string{"/(/"}}).Validate())
+}
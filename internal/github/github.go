@@ -2,11 +2,12 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 	"sync"
 	"sync/atomic"
-	"time"
 
 	"github.com/google/go-github/v41/github"
 	"golang.org/x/sync/errgroup"
@@ -17,32 +18,167 @@ const (
 )
 
 type Client struct {
-	client *github.Client
+	client     *github.Client
+	tagFilter  func(repo, tag string) bool
+	tagCompare func(repo, a, b string) int
+	repoFilter func(repo *github.Repository) bool
+	// noise commits that don't make a repository releasable.
+	noise *NoiseFilter
+	// reads and writes limit concurrent calls to GitHub to avoid secondary rate limits.
+	reads  *pool
+	writes *pool
+	// transport waits out rate limits of every request.
+	transport *rateLimitTransport
+	// http authenticated client for GraphQL queries at graphqlURL.
+	http       *http.Client
+	graphqlURL string
+	discovery  Discovery
+	// fileStats whether GraphQL discovery compares repositories for FilesChanged, Additions and Deletions.
+	fileStats bool
+}
+
+// Rate the rate limit budget of the latest response from GitHub.
+func (gh *Client) Rate() Rate {
+	return gh.transport.Rate()
 }
 
 type RepositoryRelease struct {
-	Name      string
-	Version   string
-	Body      string
-	TargetSHA string
+	// Owner user or organization of the repository.
+	Owner   string `yaml:"owner" json:"owner"`
+	Name    string `yaml:"name" json:"name"`
+	Version string `yaml:"version" json:"version"`
+	// Change description of how Version was determined.
+	Change string `yaml:"change" json:"change"`
+	Body   string `yaml:"body" json:"body"`
+	// BaselineTag the latest tag the release is based on, empty when there is no previous tag.
+	BaselineTag string `yaml:"baseline_tag" json:"baseline_tag"`
+	TargetSHA   string `yaml:"target_sha" json:"target_sha"`
+	// Branch the branch TargetSHA is on, updated when there are Files.
+	Branch string `yaml:"branch" json:"branch"`
+	// Prerelease marks the GitHub release as a pre-release.
+	Prerelease bool `yaml:"prerelease" json:"prerelease"`
+	// Files containing the version that are committed to Branch prior to creating the release, the release then targets
+	// the new commit instead of TargetSHA.
+	Files []VersionFile `yaml:"files,omitempty" json:"files,omitempty"`
+	// Commits since BaselineTag.
+	Commits []Commit `yaml:"commits" json:"commits"`
+	// Draft creates the release as a draft to publish later.
+	Draft bool `yaml:"draft" json:"draft"`
+	// Assets paths of files uploaded to the release after it's created.
+	Assets []string `yaml:"assets,omitempty" json:"assets,omitempty"`
+	// Checksums uploads a ChecksumsFilename asset with the SHA-256 checksum of every asset.
+	Checksums bool `yaml:"checksums,omitempty" json:"checksums,omitempty"`
+}
+
+// Commit a commit included in a release.
+type Commit struct {
+	SHA     string `yaml:"sha" json:"sha"`
+	Summary string `yaml:"summary" json:"summary"`
 }
 
 type RepositoryReleaseResponse struct {
 	Owner   string
 	Name    string
 	Version string
+	Change  string
 	Body    string
 	URL     string
 	Error   error
+	// Warning CI of the target wasn't passing when the release was created.
+	Warning string
 }
 
 func (rrr *RepositoryReleaseResponse) IsError() bool {
 	return rrr.Error != nil
 }
 
-func (gh *Client) CreateReleases(ctx context.Context, owner string, releases []*RepositoryRelease, createReleaseBranch bool) []*RepositoryReleaseResponse {
+// VerifyReleases checks that releases can still be created as planned, returns an error for every release that can't.
+func (gh *Client) VerifyReleases(ctx context.Context, releases []*RepositoryRelease) []error {
+	c := make(chan error, len(releases))
+
+	var wg sync.WaitGroup
+	wg.Add(len(releases))
+
+	for _, release := range releases {
+		release := release
+
+		go func() {
+			defer wg.Done()
+
+			err := gh.reads.Do(ctx, func() error {
+				return gh.verifyRelease(ctx, release.Owner, release)
+			})
+			if err != nil {
+				c <- fmt.Errorf("%s/%s: %v", release.Owner, release.Name, err)
+			}
+		}()
+	}
+
+	wg.Wait()
+	close(c)
+
+	var errs []error
+	for err := range c {
+		errs = append(errs, err)
+	}
+
+	return errs
+}
+
+// verifyRelease checks that Branch still points to TargetSHA and the tag doesn't exist.
+func (gh *Client) verifyRelease(ctx context.Context, owner string, release *RepositoryRelease) error {
+	branch, r, err := gh.client.Repositories.GetBranch(ctx, owner, release.Name, release.Branch, true)
+	if err != nil {
+		return fmt.Errorf("failed to get branch %s: %v", release.Branch, err)
+	}
+
+	if err := github.CheckResponse(r.Response); err != nil {
+		return fmt.Errorf("failed to get branch %s: %v", release.Branch, err)
+	}
+
+	if sha := branch.GetCommit().GetSHA(); sha != release.TargetSHA {
+		return fmt.Errorf("branch %s has moved to %s since it was planned at %s", release.Branch, sha, release.TargetSHA)
+	}
+
+	exists, err := gh.tagExists(ctx, owner, release.Name, release.Version)
+	if err != nil {
+		return err
+	}
+
+	if exists {
+		return fmt.Errorf("tag %s already exists", release.Version)
+	}
+
+	drafted, err := gh.drafted(ctx, owner, release.Name, release.Version)
+	if err != nil {
+		return err
+	}
+
+	if drafted {
+		return fmt.Errorf("draft release %s already exists", release.Version)
+	}
+
+	return nil
+}
+
+// CreateOptions how a batch of releases is created.
+type CreateOptions struct {
+	CreateReleaseBranch bool
+	// Atomic rolls back every release of the batch when any release fails.
+	Atomic bool
+	// Journal records everything created, so the batch can be rolled back later.
+	Journal *Journal
+	// CI what happens when CI of the target of a release is failing or pending, empty doesn't check CI.
+	CI CIGate
+}
+
+// CreateReleases creates releases, a release that fails is rolled back so nothing of it is left behind.
+func (gh *Client) CreateReleases(ctx context.Context, releases []*RepositoryRelease, options CreateOptions) []*RepositoryReleaseResponse {
 	c := make(chan *RepositoryReleaseResponse, len(releases))
 
+	var mu sync.Mutex
+	journals := make(map[*RepositoryReleaseResponse]*Journal, len(releases))
+
 	var wg sync.WaitGroup
 	wg.Add(len(releases))
 
@@ -52,11 +188,31 @@ func (gh *Client) CreateReleases(ctx context.Context, owner string, releases []*
 		go func() {
 			defer wg.Done()
 
-			r, err := gh.createRelease(ctx, owner, release, createReleaseBranch)
-			response := &RepositoryReleaseResponse{Owner: owner, Name: release.Name, Body: release.Body, Version: release.Version, Error: err}
+			journal := &Journal{}
+
+			var r *github.RepositoryRelease
+			warning, err := gh.checkCI(ctx, release, options.CI)
+			if err == nil {
+				err = gh.writes.Do(ctx, func() (err error) {
+					r, err = gh.createRelease(ctx, release.Owner, release, options.CreateReleaseBranch, journal)
+					return err
+				})
+			}
+			if err != nil {
+				err = gh.rollbackRelease(ctx, journal, err)
+
+				// Whatever failed to roll back can be rolled back later
+				options.Journal.Append(journal)
+			}
+
+			response := &RepositoryReleaseResponse{Owner: release.Owner, Name: release.Name, Body: release.Body, Version: release.Version, Change: release.Change, Error: err, Warning: warning}
 
 			if err == nil {
 				response.URL = r.GetHTMLURL()
+
+				mu.Lock()
+				journals[response] = journal
+				mu.Unlock()
 			}
 
 			c <- response
@@ -69,20 +225,89 @@ func (gh *Client) CreateReleases(ctx context.Context, owner string, releases []*
 	}()
 
 	var releaseResponses []*RepositoryReleaseResponse
+	failed := false
 	for release := range c {
 		releaseResponses = append(releaseResponses, release)
+		failed = failed || release.IsError()
+	}
+
+	if options.Atomic && failed {
+		for response, journal := range journals {
+			response.Error = gh.rollbackRelease(ctx, journal, errors.New("another release of the batch failed"))
+			options.Journal.Append(journal)
+		}
+
+		return releaseResponses
+	}
+
+	for _, journal := range journals {
+		options.Journal.Append(journal)
 	}
 
 	return releaseResponses
 }
 
+// rollbackRelease rolls back everything created for a release that failed with cause.
+func (gh *Client) rollbackRelease(ctx context.Context, journal *Journal, cause error) error {
+	if len(journal.Entries) == 0 {
+		return cause
+	}
+
+	if errs := gh.Rollback(ctx, journal); len(errs) > 0 {
+		return fmt.Errorf("%v, %v", cause, joinErrors(errs))
+	}
+
+	return fmt.Errorf("%v, rolled back", cause)
+}
+
+func joinErrors(errs []error) string {
+	messages := make([]string, 0, len(errs))
+	for _, err := range errs {
+		messages = append(messages, err.Error())
+	}
+
+	return strings.Join(messages, ", ")
+}
+
 // createRelease Creates a GitHub release provided the owner and other release information where the name of the release and the tag will be the version.
-func (gh *Client) createRelease(ctx context.Context, owner string, releaseInfo *RepositoryRelease, createReleaseBranch bool) (*github.RepositoryRelease, error) {
+//
+// Everything created is recorded in journal.
+func (gh *Client) createRelease(ctx context.Context, owner string, releaseInfo *RepositoryRelease, createReleaseBranch bool, journal *Journal) (*github.RepositoryRelease, error) {
+	target := releaseInfo.TargetSHA
+
+	// GitHub doesn't refuse a release of a tag that is only drafted
+	drafted, err := gh.drafted(ctx, owner, releaseInfo.Name, releaseInfo.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	if drafted {
+		return nil, fmt.Errorf("draft release %s already exists, publish it with releaser publish-drafts", releaseInfo.Version)
+	}
+
+	if len(releaseInfo.Files) > 0 {
+		sha, err := gh.commitVersionFiles(ctx, owner, releaseInfo)
+		if err != nil {
+			return nil, fmt.Errorf("failed to update version files: %v", err)
+		}
+
+		journal.Record(&Entry{Kind: CommitEntry, Owner: owner, Repo: releaseInfo.Name, Ref: releaseInfo.Branch, SHA: sha, Parent: target})
+		target = sha
+	}
+
 	release := &github.RepositoryRelease{
 		TagName:         github.String(releaseInfo.Version),
 		Body:            github.String(releaseInfo.Body),
 		Name:            github.String(releaseInfo.Version),
-		TargetCommitish: github.String(releaseInfo.TargetSHA),
+		TargetCommitish: github.String(target),
+		Prerelease:      github.Bool(releaseInfo.Prerelease),
+		Draft:           github.Bool(releaseInfo.Draft),
+	}
+
+	// GitHub releases an existing tag instead of creating it
+	existingTag, err := gh.tagExists(ctx, owner, releaseInfo.Name, releaseInfo.Version)
+	if err != nil {
+		return nil, err
 	}
 
 	releaseResponse, r, err := gh.client.Repositories.CreateRelease(ctx, owner, releaseInfo.Name, release)
@@ -94,23 +319,68 @@ func (gh *Client) createRelease(ctx context.Context, owner string, releaseInfo *
 		return nil, err
 	}
 
+	// Drafts don't create their tag until published
+	if !releaseInfo.Draft && !existingTag {
+		journal.Record(&Entry{Kind: TagEntry, Owner: owner, Repo: releaseInfo.Name, Ref: releaseInfo.Version})
+	}
+
+	journal.Record(&Entry{Kind: ReleaseEntry, Owner: owner, Repo: releaseInfo.Name, ID: releaseResponse.GetID()})
+
+	// Assets are deleted along with the release when rolled back
+	if err := gh.uploadAssets(ctx, owner, releaseInfo, releaseResponse.GetID()); err != nil {
+		return nil, fmt.Errorf("failed to upload assets: %v", err)
+	}
+
 	if createReleaseBranch {
 		releaseBranch := fmt.Sprintf("refs/heads/%s", releaseInfo.Version)
 
-		ref := &github.Reference{Ref: github.String(releaseBranch), Object: &github.GitObject{SHA: github.String(releaseInfo.TargetSHA)}}
+		ref := &github.Reference{Ref: github.String(releaseBranch), Object: &github.GitObject{SHA: github.String(target)}}
 		_, r, err := gh.client.Git.CreateRef(ctx, owner, releaseInfo.Name, ref)
 		if err != nil {
-			return nil, err
+			return nil, fmt.Errorf("failed to create release branch %s: %v", releaseInfo.Version, err)
 		}
 
 		if err := github.CheckResponse(r.Response); err != nil {
-			return nil, err
+			return nil, fmt.Errorf("failed to create release branch %s: %v", releaseInfo.Version, err)
 		}
+
+		journal.Record(&Entry{Kind: BranchEntry, Owner: owner, Repo: releaseInfo.Name, Ref: releaseInfo.Version})
 	}
 
 	return releaseResponse, nil
 }
 
+// drafted whether a draft release of repo has tag, drafts don't create their tag until published.
+func (gh *Client) drafted(ctx context.Context, owner, repo, tag string) (bool, error) {
+	drafts, err := gh.drafts(ctx, owner, repo)
+	if err != nil {
+		return false, err
+	}
+
+	for _, draft := range drafts {
+		if draft.GetTagName() == tag {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// tagExists whether the tag exists in repo.
+func (gh *Client) tagExists(ctx context.Context, owner, repo, tag string) (bool, error) {
+	_, r, err := gh.client.Git.GetRef(ctx, owner, repo, "tags/"+tag)
+	if r != nil && r.StatusCode == http.StatusNotFound {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, fmt.Errorf("failed to check if tag %s exists: %v", tag, err)
+	}
+
+	return true, nil
+}
+
+// tags all tags of a repository that are accepted by the Client's tag filter.
 func (gh *Client) tags(ctx context.Context, owner, repo string) ([]*github.RepositoryTag, error) {
 	next := 1
 
@@ -123,12 +393,6 @@ func (gh *Client) tags(ctx context.Context, owner, repo string) ([]*github.Repos
 			break
 		}
 
-		if r.Rate.Remaining == 0 {
-			rateLimit := time.Until(r.Rate.Reset.Time)
-			time.Sleep(rateLimit)
-			continue
-		}
-
 		if err != nil {
 			return nil, err
 		}
@@ -137,7 +401,11 @@ func (gh *Client) tags(ctx context.Context, owner, repo string) ([]*github.Repos
 			return nil, err
 		}
 
-		tags = append(tags, responseTags...)
+		for _, tag := range responseTags {
+			if gh.tagFilter(repo, tag.GetName()) {
+				tags = append(tags, tag)
+			}
+		}
 
 		next = r.NextPage
 		if next == 0 {
@@ -148,10 +416,33 @@ func (gh *Client) tags(ctx context.Context, owner, repo string) ([]*github.Repos
 	return tags, nil
 }
 
+// highestTag the highest of tags, nil when there are no tags.
+func (gh *Client) highestTag(repo string, tags []*github.RepositoryTag) *github.RepositoryTag {
+	var highest *github.RepositoryTag
+
+	for _, tag := range tags {
+		if highest == nil || gh.tagCompare(repo, highest.GetName(), tag.GetName()) < 0 {
+			highest = tag
+		}
+	}
+
+	return highest
+}
+
 // mostRecentTagAndChanges get the most recent commits and determine most recent tag for a branch, if branch is not provided the default branch will be used.
-func (gh *Client) mostRecentTagAndChanges(ctx context.Context, owner, repo string, tags []*github.RepositoryTag, branch string) (string, *github.RepositoryTag, []*github.RepositoryCommit, error) {
+//
+// Walks at most limit commits.
+//
+// When several tags point to the same commit the highest is the most recent.
+func (gh *Client) mostRecentTagAndChanges(ctx context.Context, owner, repo string, tags []*github.RepositoryTag, branch string, limit int) (string, *github.RepositoryTag, []*github.RepositoryCommit, error) {
 	next := 1
 
+	tagsBySHA := make(map[string][]*github.RepositoryTag)
+	for _, tag := range tags {
+		sha := tag.GetCommit().GetSHA()
+		tagsBySHA[sha] = append(tagsBySHA[sha], tag)
+	}
+
 	var commitsSince []*github.RepositoryCommit
 	for {
 		options := &github.CommitsListOptions{SHA: branch, ListOptions: github.ListOptions{PerPage: githubMaxPerPage, Page: next}}
@@ -173,12 +464,6 @@ func (gh *Client) mostRecentTagAndChanges(ctx context.Context, owner, repo strin
 			break
 		}
 
-		if r.Rate.Remaining == 0 {
-			rateLimit := time.Until(r.Rate.Reset.Time)
-			time.Sleep(rateLimit)
-			continue
-		}
-
 		if err != nil {
 			return "", nil, nil, err
 		}
@@ -192,14 +477,15 @@ func (gh *Client) mostRecentTagAndChanges(ctx context.Context, owner, repo strin
 		}
 
 		for _, commit := range commits {
-			for _, tag := range tags {
-				// find most recent tag associated to this branch
-				if commit.GetSHA() == tag.GetCommit().GetSHA() {
-					return branch, tag, commitsSince, nil
-				}
+			// find most recent tag associated to this branch
+			if tagged, ok := tagsBySHA[commit.GetSHA()]; ok {
+				return branch, gh.highestTag(repo, tagged), commitsSince, nil
 			}
 
 			commitsSince = append(commitsSince, commit)
+			if len(commitsSince) >= limit {
+				return branch, nil, commitsSince, nil
+			}
 		}
 
 		next = r.NextPage
@@ -224,12 +510,6 @@ func (gh *Client) branches(ctx context.Context, owner, repo string) ([]*github.B
 			break
 		}
 
-		if r.Rate.Remaining == 0 {
-			rateLimit := time.Until(r.Rate.Reset.Time)
-			time.Sleep(rateLimit)
-			continue
-		}
-
 		if err != nil {
 			return nil, err
 		}
@@ -256,8 +536,21 @@ type ReleaseableRepoResponse struct {
 	Commits   []*github.RepositoryCommit
 	Repo      *github.Repository
 	LatestTag *github.RepositoryTag
-	Branches  []*github.Branch
-	Branch    string
+	// HighestTag the highest tag in the repository which may not be reachable from Branch.
+	HighestTag *github.RepositoryTag
+	Branches   []*github.Branch
+	Branch     string
+	// AheadBy commits on Branch since LatestTag, may exceed Commits for repositories without a tag on Branch.
+	AheadBy int
+	// FilesChanged, Additions and Deletions since LatestTag, include at most 300 files and are 0 without a tag on Branch.
+	// GraphQL discovery only resolves them when used by the template.
+	FilesChanged int
+	Additions    int
+	Deletions    int
+	// PullRequests merged pull requests of Commits by SHA, only resolved when used by the template.
+	PullRequests map[string]*github.PullRequest
+	// Noise commits of Commits by SHA discounted by the noise filter, at least one commit isn't noise.
+	Noise map[string]bool
 }
 
 func (gh *Client) ReleaseableRepo(ctx context.Context, org string, repo *github.Repository, branch string) (*ReleaseableRepoResponse, error) {
@@ -273,39 +566,64 @@ func (gh *Client) ReleaseableRepo(ctx context.Context, org string, repo *github.
 		return nil, nil
 	}
 
+	if !gh.repoFilter(repo) {
+		return nil, nil
+	}
+
 	tags, err := gh.tags(ctx, org, repo.GetName())
 	if err != nil {
 		return nil, fmt.Errorf("failed to get latest tag for %s/%s: %v", org, repo.GetName(), err)
 	}
 
-	branch, tag, commits, err := gh.mostRecentTagAndChanges(ctx, org, repo.GetName(), tags, branch)
+	changes, err := gh.changesSinceLatestTag(ctx, org, repo, tags, branch)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get commits on branch %s for %s/%s: %v", branch, org, repo.GetName(), err)
 	}
 
-	if len(commits) == 0 {
+	if len(changes.commits) == 0 {
 		return nil, nil
 	}
 
-	branches, err := gh.branches(ctx, org, repo.GetName())
+	noise, err := gh.noiseCommits(ctx, org, repo.GetName(), changes.commits)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get branches for %s/%s: %v", org, repo.GetName(), err)
+		return nil, err
+	}
+
+	if len(noise) == len(changes.commits) {
+		return nil, nil
 	}
 
-	if branch == "" {
-		branch = repo.GetDefaultBranch()
+	branches, err := gh.branches(ctx, org, repo.GetName())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get branches for %s/%s: %v", org, repo.GetName(), err)
 	}
 
 	return &ReleaseableRepoResponse{
-		Commits:   commits,
-		LatestTag: tag,
-		Repo:      repo,
-		Branches:  branches,
-		Branch:    branch,
+		Commits:      changes.commits,
+		LatestTag:    changes.tag,
+		HighestTag:   gh.highestTag(repo.GetName(), tags),
+		Repo:         repo,
+		Branches:     branches,
+		Branch:       changes.branch,
+		AheadBy:      changes.aheadBy,
+		FilesChanged: changes.filesChanged,
+		Additions:    changes.additions,
+		Deletions:    changes.deletions,
+		Noise:        noise,
 	}, nil
 }
 
+// ReleaseableReposByUser async retrival of GitHub repositories of the authenticated user. Returns a channel to listen
+// to for ReleasableRepos and the function to run as a goroutine to acquire them.
 func (gh *Client) ReleaseableReposByUser(ctx context.Context, user, branch string) (<-chan *ReleaseableRepoResponse, func() error) {
+	if gh.discovery == GraphQLDiscovery {
+		return gh.graphqlReleasableRepos(ctx, user, true, branch)
+	}
+
+	return gh.restReleaseableReposByUser(ctx, user, branch)
+}
+
+func (gh *Client) restReleaseableReposByUser(ctx context.Context, user, branch string) (<-chan *ReleaseableRepoResponse, func() error) {
 	c := make(chan *ReleaseableRepoResponse)
 	next := 1
 	var total int32
@@ -336,7 +654,12 @@ func (gh *Client) ReleaseableReposByUser(ctx context.Context, user, branch strin
 				repo := repo
 
 				errGrp.Go(func() error {
-					releaseableRepo, err := gh.ReleaseableRepo(ctx, user, repo, branch)
+					var releaseableRepo *ReleaseableRepoResponse
+
+					err := gh.reads.Do(ctx, func() (err error) {
+						releaseableRepo, err = gh.ReleaseableRepo(ctx, user, repo, branch)
+						return err
+					})
 					if err != nil {
 						return err
 					}
@@ -371,6 +694,14 @@ func (gh *Client) ReleaseableReposByUser(ctx context.Context, user, branch strin
 //
 // Filters out: archived, templates, repositories with 0 new commits since last Tag
 func (gh *Client) ReleasableReposByOrg(ctx context.Context, org, branch string) (<-chan *ReleaseableRepoResponse, func() error) {
+	if gh.discovery == GraphQLDiscovery {
+		return gh.graphqlReleasableRepos(ctx, org, false, branch)
+	}
+
+	return gh.restReleasableReposByOrg(ctx, org, branch)
+}
+
+func (gh *Client) restReleasableReposByOrg(ctx context.Context, org, branch string) (<-chan *ReleaseableRepoResponse, func() error) {
 	c := make(chan *ReleaseableRepoResponse)
 	next := 1
 	var total int32
@@ -388,12 +719,6 @@ func (gh *Client) ReleasableReposByOrg(ctx context.Context, org, branch string)
 				break
 			}
 
-			if r.Rate.Remaining == 0 {
-				rateLimit := time.Until(r.Rate.Reset.Time)
-				time.Sleep(rateLimit)
-				continue
-			}
-
 			if err != nil {
 				return err
 			}
@@ -412,7 +737,12 @@ func (gh *Client) ReleasableReposByOrg(ctx context.Context, org, branch string)
 				repo := repo
 
 				errGrp.Go(func() error {
-					releaseableRepo, err := gh.ReleaseableRepo(ctx, org, repo, branch)
+					var releaseableRepo *ReleaseableRepoResponse
+
+					err := gh.reads.Do(ctx, func() (err error) {
+						releaseableRepo, err = gh.ReleaseableRepo(ctx, org, repo, branch)
+						return err
+					})
 					if err != nil {
 						return err
 					}
@@ -485,12 +815,6 @@ func (gh *Client) Orgs(ctx context.Context) (<-chan *OrgResponse, func() error)
 				break
 			}
 
-			if r.Rate.Remaining == 0 {
-				rateLimit := time.Until(r.Rate.Reset.Time)
-				time.Sleep(rateLimit)
-				continue
-			}
-
 			if err != nil {
 				return fmt.Errorf("failed to get organizations for authenticated user: %v", err)
 			}
@@ -503,7 +827,13 @@ func (gh *Client) Orgs(ctx context.Context) (<-chan *OrgResponse, func() error)
 				org := org
 
 				errGrp.Go(func() error {
-					orgInfo, r, err := gh.client.Organizations.Get(ctx, org.GetLogin())
+					var orgInfo *github.Organization
+					var r *github.Response
+
+					err := gh.reads.Do(ctx, func() (err error) {
+						orgInfo, r, err = gh.client.Organizations.Get(ctx, org.GetLogin())
+						return err
+					})
 					if err != nil {
 						return fmt.Errorf("failed to get additional information for organization %s: %v", org.GetLogin(), err)
 					}
@@ -544,13 +874,6 @@ func (gh *Client) User(ctx context.Context) (*github.User, error) {
 		return nil, err
 	}
 
-	if r.Rate.Remaining == 0 {
-		rateLimit := time.Until(r.Rate.Reset.Time)
-		time.Sleep(rateLimit)
-
-		return gh.User(ctx)
-	}
-
 	if err != nil {
 		return nil, fmt.Errorf("failed to get authenticated user: %v", err)
 	}
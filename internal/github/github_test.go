@@ -0,0 +1,25 @@
+package github
+
+import (
+	"testing"
+
+	"github.com/google/go-github/v41/github"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHighestTag(t *testing.T) {
+	gh, err := New().Token("token").TagCompare(func(repo, a, b string) int {
+		return len(a) - len(b)
+	}).Build()
+	assert.NoError(t, err)
+
+	assert.Nil(t, gh.highestTag("example", nil))
+
+	tags := []*github.RepositoryTag{
+		{Name: github.String("v1.0.0")},
+		{Name: github.String("v10.0.0")},
+		{Name: github.String("v2.0.0")},
+	}
+
+	assert.Equal(t, "v10.0.0", gh.highestTag("example", tags).GetName())
+}
@@ -0,0 +1,546 @@
+package github
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/go-github/v41/github"
+	"golang.org/x/sync/errgroup"
+)
+
+type Discovery string
+
+const (
+	// RESTDiscovery discovers releasable repositories with several REST calls per repository.
+	RESTDiscovery Discovery = "rest"
+	// GraphQLDiscovery discovers releasable repositories of many repositories per GraphQL query, falls back to
+	// RESTDiscovery when GraphQL is unavailable.
+	GraphQLDiscovery Discovery = "graphql"
+)
+
+// DiscoveryFromString parses discovery, defaults to RESTDiscovery when empty.
+func DiscoveryFromString(discovery string) (Discovery, error) {
+	if discovery == "" {
+		return RESTDiscovery, nil
+	}
+
+	d := Discovery(discovery)
+	if d != RESTDiscovery && d != GraphQLDiscovery {
+		return "", fmt.Errorf("invalid discovery '%s' expected one of 'rest', 'graphql'", discovery)
+	}
+
+	return d, nil
+}
+
+var errGraphQLUnavailable = errors.New("GraphQL API is unavailable")
+
+// graphqlRepositoryFields of each repository up to 100 commits, tags and branches.
+const graphqlRepositoryFields = `
+fragment commit on Commit {
+  oid
+  url
+  message
+  author { name email date user { login url } }
+  committer { name email date user { login url } }
+}
+
+fragment repository on Repository {
+  name
+  description
+  url
+  isArchived
+  isTemplate
+  isFork
+  isPrivate
+  visibility
+  primaryLanguage { name }
+  repositoryTopics(first: 20) { nodes { topic { name } } }
+  owner { login }
+  defaultBranchRef {
+    name
+    target { ... on Commit { history(first: 100) { pageInfo { hasNextPage } nodes { ...commit } } } }
+  }
+  branchRef: ref(qualifiedName: $branch) @include(if: $hasBranch) {
+    name
+    target { ... on Commit { history(first: 100) { pageInfo { hasNextPage } nodes { ...commit } } } }
+  }
+  tags: refs(refPrefix: "refs/tags/", first: 100) {
+    pageInfo { hasNextPage }
+    nodes { name target { oid ... on Tag { target { oid } } } }
+  }
+  branches: refs(refPrefix: "refs/heads/", first: 100) {
+    pageInfo { hasNextPage }
+    nodes { name target { oid } }
+  }
+}
+`
+
+const graphqlOrgQuery = `
+query($login: String!, $cursor: String, $branch: String!, $hasBranch: Boolean!) {
+  owner: organization(login: $login) {
+    repositories(first: 20, after: $cursor, orderBy: { field: UPDATED_AT, direction: DESC }) {
+      totalCount
+      pageInfo { hasNextPage endCursor }
+      nodes { ...repository }
+    }
+  }
+}
+` + graphqlRepositoryFields
+
+const graphqlUserQuery = `
+query($cursor: String, $branch: String!, $hasBranch: Boolean!) {
+  owner: viewer {
+    repositories(first: 20, after: $cursor, ownerAffiliations: OWNER, orderBy: { field: UPDATED_AT, direction: DESC }) {
+      totalCount
+      pageInfo { hasNextPage endCursor }
+      nodes { ...repository }
+    }
+  }
+}
+` + graphqlRepositoryFields
+
+type graphqlPageInfo struct {
+	HasNextPage bool   `json:"hasNextPage"`
+	EndCursor   string `json:"endCursor"`
+}
+
+type graphqlUser struct {
+	Login string `json:"login"`
+	URL   string `json:"url"`
+}
+
+type graphqlSignature struct {
+	Name  string       `json:"name"`
+	Email string       `json:"email"`
+	Date  time.Time    `json:"date"`
+	User  *graphqlUser `json:"user"`
+}
+
+type graphqlCommit struct {
+	OID       string           `json:"oid"`
+	URL       string           `json:"url"`
+	Message   string           `json:"message"`
+	Author    graphqlSignature `json:"author"`
+	Committer graphqlSignature `json:"committer"`
+}
+
+type graphqlBranchRef struct {
+	Name   string `json:"name"`
+	Target struct {
+		History struct {
+			PageInfo graphqlPageInfo `json:"pageInfo"`
+			Nodes    []graphqlCommit `json:"nodes"`
+		} `json:"history"`
+	} `json:"target"`
+}
+
+type graphqlRefs struct {
+	PageInfo graphqlPageInfo `json:"pageInfo"`
+	Nodes    []struct {
+		Name   string `json:"name"`
+		Target struct {
+			OID string `json:"oid"`
+			// Target the commit of annotated tags.
+			Target *struct {
+				OID string `json:"oid"`
+			} `json:"target"`
+		} `json:"target"`
+	} `json:"nodes"`
+}
+
+type graphqlRepository struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	URL         string `json:"url"`
+	IsArchived  bool   `json:"isArchived"`
+	IsTemplate  bool   `json:"isTemplate"`
+	IsFork      bool   `json:"isFork"`
+	IsPrivate   bool   `json:"isPrivate"`
+	Visibility  string `json:"visibility"`
+	Owner       struct {
+		Login string `json:"login"`
+	} `json:"owner"`
+	PrimaryLanguage *struct {
+		Name string `json:"name"`
+	} `json:"primaryLanguage"`
+	RepositoryTopics struct {
+		Nodes []struct {
+			Topic struct {
+				Name string `json:"name"`
+			} `json:"topic"`
+		} `json:"nodes"`
+	} `json:"repositoryTopics"`
+	DefaultBranchRef *graphqlBranchRef `json:"defaultBranchRef"`
+	BranchRef        *graphqlBranchRef `json:"branchRef"`
+	Tags             graphqlRefs       `json:"tags"`
+	Branches         graphqlRefs       `json:"branches"`
+}
+
+type graphqlRepositoriesResponse struct {
+	Data struct {
+		Owner *struct {
+			Repositories struct {
+				TotalCount int                 `json:"totalCount"`
+				PageInfo   graphqlPageInfo     `json:"pageInfo"`
+				Nodes      []graphqlRepository `json:"nodes"`
+			} `json:"repositories"`
+		} `json:"owner"`
+	} `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+// graphql executes query with variables decoding the response into result.
+func (gh *Client) graphql(ctx context.Context, query string, variables map[string]interface{}, result interface{}) error {
+	body, err := json.Marshal(map[string]interface{}{"query": query, "variables": variables})
+	if err != nil {
+		return fmt.Errorf("failed to serialize query: %v", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, gh.graphqlURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := gh.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	// GitHub Enterprise instances without GraphQL
+	if resp.StatusCode == http.StatusNotFound {
+		return errGraphQLUnavailable
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("GraphQL query failed with %s", resp.Status)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+		return fmt.Errorf("failed to parse GraphQL response: %v", err)
+	}
+
+	return nil
+}
+
+// graphqlReleasableRepos discovers the releasable repositories of owner using GraphQL, when user is true owner is the
+// authenticated user. Repositories with more tags or commits since their latest tag than a query includes are
+// discovered using REST.
+func (gh *Client) graphqlReleasableRepos(ctx context.Context, owner string, user bool, branch string) (<-chan *ReleaseableRepoResponse, func() error) {
+	c := make(chan *ReleaseableRepoResponse)
+	var total int32
+
+	errGrp, ctx := errgroup.WithContext(ctx)
+
+	query := graphqlOrgQuery
+	variables := map[string]interface{}{"login": owner, "branch": "refs/heads/" + branch, "hasBranch": branch != ""}
+	if user {
+		query = graphqlUserQuery
+		delete(variables, "login")
+	}
+
+	return c, func() error {
+		defer close(c)
+
+		var cursor *string
+		for {
+			variables["cursor"] = cursor
+
+			var response graphqlRepositoriesResponse
+			err := gh.reads.Do(ctx, func() error {
+				return gh.graphql(ctx, query, variables, &response)
+			})
+
+			// Only fall back prior to discovering any repository
+			if errors.Is(err, errGraphQLUnavailable) && cursor == nil {
+				return gh.forward(ctx, c, owner, user, branch)
+			}
+
+			if err != nil {
+				return fmt.Errorf("failed to discover repositories for %s: %v", owner, err)
+			}
+
+			if len(response.Errors) > 0 || response.Data.Owner == nil {
+				messages := make([]string, 0, len(response.Errors))
+				for _, e := range response.Errors {
+					messages = append(messages, e.Message)
+				}
+
+				return fmt.Errorf("failed to discover repositories for %s: %s", owner, strings.Join(messages, ", "))
+			}
+
+			repositories := response.Data.Owner.Repositories
+			atomic.AddInt32(&total, int32(len(repositories.Nodes)))
+
+			for _, node := range repositories.Nodes {
+				node := node
+
+				errGrp.Go(func() error {
+					releaseableRepo, err := gh.graphqlReleaseableRepo(ctx, &node, branch)
+					if err != nil {
+						return err
+					}
+
+					if releaseableRepo == nil {
+						atomic.AddInt32(&total, -1)
+						return nil
+					}
+
+					releaseableRepo.Total = atomic.LoadInt32(&total)
+					c <- releaseableRepo
+					return nil
+				})
+			}
+
+			if !repositories.PageInfo.HasNextPage {
+				break
+			}
+
+			cursor = &repositories.PageInfo.EndCursor
+		}
+
+		return errGrp.Wait()
+	}
+}
+
+// forward discovers the releasable repositories of owner using REST sending them to c.
+func (gh *Client) forward(ctx context.Context, c chan<- *ReleaseableRepoResponse, owner string, user bool, branch string) error {
+	var channel <-chan *ReleaseableRepoResponse
+	var callback func() error
+
+	if user {
+		channel, callback = gh.restReleaseableReposByUser(ctx, owner, branch)
+	} else {
+		channel, callback = gh.restReleasableReposByOrg(ctx, owner, branch)
+	}
+
+	errGrp, ctx := errgroup.WithContext(ctx)
+	errGrp.Go(callback)
+
+	for repo := range channel {
+		c <- repo
+	}
+
+	return errGrp.Wait()
+}
+
+// graphqlReleaseableRepo converts a repository of a GraphQL query to a ReleaseableRepoResponse, nil when the repository
+// has nothing to release. Uses REST when the query didn't include the latest tag.
+func (gh *Client) graphqlReleaseableRepo(ctx context.Context, node *graphqlRepository, branch string) (*ReleaseableRepoResponse, error) {
+	repo := node.repository()
+
+	if node.IsTemplate || node.IsArchived || node.DefaultBranchRef == nil || !gh.repoFilter(repo) {
+		return nil, nil
+	}
+
+	ref := node.BranchRef
+	if ref == nil {
+		// Branch not found, use the default branch
+		ref = node.DefaultBranchRef
+	}
+
+	var tags []*github.RepositoryTag
+	for _, tag := range node.Tags.Nodes {
+		sha := tag.Target.OID
+		if tag.Target.Target != nil {
+			// Annotated tag
+			sha = tag.Target.Target.OID
+		}
+
+		if gh.tagFilter(node.Name, tag.Name) {
+			tags = append(tags, &github.RepositoryTag{Name: github.String(tag.Name), Commit: &github.Commit{SHA: github.String(sha)}})
+		}
+	}
+
+	latest, commits, found := gh.graphqlChanges(node.Name, tags, ref)
+
+	incomplete := node.Tags.PageInfo.HasNextPage || node.Branches.PageInfo.HasNextPage || !found
+	if incomplete {
+		var releaseableRepo *ReleaseableRepoResponse
+
+		err := gh.reads.Do(ctx, func() (err error) {
+			releaseableRepo, err = gh.ReleaseableRepo(ctx, node.Owner.Login, repo, branch)
+			return err
+		})
+
+		return releaseableRepo, err
+	}
+
+	if len(commits) == 0 {
+		return nil, nil
+	}
+
+	var noise map[string]bool
+	err := gh.reads.Do(ctx, func() (err error) {
+		noise, err = gh.noiseCommits(ctx, node.Owner.Login, node.Name, commits)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(noise) == len(commits) {
+		return nil, nil
+	}
+
+	branches := make([]*github.Branch, 0, len(node.Branches.Nodes))
+	for _, b := range node.Branches.Nodes {
+		branches = append(branches, &github.Branch{Name: github.String(b.Name), Commit: &github.RepositoryCommit{SHA: github.String(b.Target.OID)}})
+	}
+
+	releaseableRepo := &ReleaseableRepoResponse{
+		Commits:    commits,
+		LatestTag:  latest,
+		HighestTag: gh.highestTag(node.Name, tags),
+		Repo:       repo,
+		Branches:   branches,
+		Branch:     ref.Name,
+		AheadBy:    len(commits),
+		Noise:      noise,
+	}
+
+	if latest == nil || !gh.fileStats {
+		return releaseableRepo, nil
+	}
+
+	// File stats are only available by comparing
+	var result *changes
+	err = gh.reads.Do(ctx, func() (err error) {
+		result, err = gh.compare(ctx, node.Owner.Login, node.Name, latest, ref.Name)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to compare %s/%s: %v", node.Owner.Login, node.Name, err)
+	}
+
+	if result != nil {
+		releaseableRepo.FilesChanged = result.filesChanged
+		releaseableRepo.Additions = result.additions
+		releaseableRepo.Deletions = result.deletions
+	}
+
+	return releaseableRepo, nil
+}
+
+// graphqlChanges the latest tag of ref and the commits since, the same as changesSinceLatestTag the latest tag is the
+// highest tag that's an ancestor of ref otherwise the most recent tag in its history. False when the queried history is
+// too short to tell.
+func (gh *Client) graphqlChanges(repo string, tags []*github.RepositoryTag, ref *graphqlBranchRef) (*github.RepositoryTag, []*github.RepositoryCommit, bool) {
+	history := ref.Target.History
+
+	positions := make(map[string]int, len(history.Nodes))
+	for i, commit := range history.Nodes {
+		positions[commit.OID] = i
+	}
+
+	var latest *github.RepositoryTag
+	end := len(history.Nodes)
+
+	for _, tag := range gh.baselineCandidates(repo, tags) {
+		if i, ok := positions[tag.GetCommit().GetSHA()]; ok {
+			latest, end = tag, i
+			break
+		}
+
+		// May be an ancestor past the queried history
+		if history.PageInfo.HasNextPage {
+			return nil, nil, false
+		}
+	}
+
+	if latest == nil {
+		tagsBySHA := make(map[string][]*github.RepositoryTag)
+		for _, tag := range tags {
+			sha := tag.GetCommit().GetSHA()
+			tagsBySHA[sha] = append(tagsBySHA[sha], tag)
+		}
+
+		for i, commit := range history.Nodes {
+			if tagged, ok := tagsBySHA[commit.OID]; ok {
+				latest, end = gh.highestTag(repo, tagged), i
+				break
+			}
+		}
+
+		if latest == nil && history.PageInfo.HasNextPage {
+			return nil, nil, false
+		}
+	}
+
+	var commits []*github.RepositoryCommit
+	for _, commit := range history.Nodes[:end] {
+		commits = append(commits, commit.repositoryCommit())
+	}
+
+	return latest, commits, true
+}
+
+func (node *graphqlRepository) repository() *github.Repository {
+	repo := &github.Repository{
+		Name:        github.String(node.Name),
+		Description: github.String(node.Description),
+		HTMLURL:     github.String(node.URL),
+		Archived:    github.Bool(node.IsArchived),
+		IsTemplate:  github.Bool(node.IsTemplate),
+		Fork:        github.Bool(node.IsFork),
+		Private:     github.Bool(node.IsPrivate),
+		Visibility:  github.String(strings.ToLower(node.Visibility)),
+		Owner:       &github.User{Login: github.String(node.Owner.Login)},
+	}
+
+	if node.PrimaryLanguage != nil {
+		repo.Language = github.String(node.PrimaryLanguage.Name)
+	}
+
+	for _, topic := range node.RepositoryTopics.Nodes {
+		repo.Topics = append(repo.Topics, topic.Topic.Name)
+	}
+
+	if node.DefaultBranchRef != nil {
+		repo.DefaultBranch = github.String(node.DefaultBranchRef.Name)
+	}
+
+	return repo
+}
+
+func (commit *graphqlCommit) repositoryCommit() *github.RepositoryCommit {
+	return &github.RepositoryCommit{
+		SHA:       github.String(commit.OID),
+		HTMLURL:   github.String(commit.URL),
+		Author:    commit.Author.user(),
+		Committer: commit.Committer.user(),
+		Commit: &github.Commit{
+			SHA:       github.String(commit.OID),
+			Message:   github.String(commit.Message),
+			Author:    commit.Author.commitAuthor(),
+			Committer: commit.Committer.commitAuthor(),
+		},
+	}
+}
+
+func (signature graphqlSignature) user() *github.User {
+	if signature.User == nil {
+		return nil
+	}
+
+	return &github.User{Login: github.String(signature.User.Login), URL: github.String(signature.User.URL)}
+}
+
+func (signature graphqlSignature) commitAuthor() *github.CommitAuthor {
+	return &github.CommitAuthor{
+		Name:  github.String(signature.Name),
+		Email: github.String(signature.Email),
+		Date:  &signature.Date,
+	}
+}
@@ -0,0 +1,289 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/v41/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const graphqlRepositoriesFixture = `{
+  "data": {
+    "owner": {
+      "repositories": {
+        "totalCount": 3,
+        "pageInfo": { "hasNextPage": false, "endCursor": "Y3Vyc29y" },
+        "nodes": [
+          {
+            "name": "example1",
+            "url": "https://github.com/example/example1",
+            "owner": { "login": "example" },
+            "defaultBranchRef": {
+              "name": "main",
+              "target": {
+                "history": {
+                  "pageInfo": { "hasNextPage": false },
+                  "nodes": [
+                    {
+                      "oid": "c3",
+                      "message": "feat: three",
+                      "author": { "name": "octocat", "date": "2022-01-31T12:00:00Z", "user": { "login": "octocat" } },
+                      "committer": { "name": "octocat", "date": "2022-01-31T12:00:00Z" }
+                    },
+                    { "oid": "c2", "message": "fix: two" },
+                    { "oid": "c1", "message": "feat: one" }
+                  ]
+                }
+              }
+            },
+            "tags": {
+              "pageInfo": { "hasNextPage": false },
+              "nodes": [
+                { "name": "v1.0.0", "target": { "oid": "c1" } },
+                { "name": "v1.1.0", "target": { "oid": "t2", "target": { "oid": "c2" } } },
+                { "name": "v2.0.0", "target": { "oid": "other" } }
+              ]
+            },
+            "branches": {
+              "pageInfo": { "hasNextPage": false },
+              "nodes": [{ "name": "main", "target": { "oid": "c3" } }]
+            }
+          },
+          {
+            "name": "released",
+            "owner": { "login": "example" },
+            "defaultBranchRef": {
+              "name": "main",
+              "target": { "history": { "pageInfo": { "hasNextPage": false }, "nodes": [{ "oid": "c1" }] } }
+            },
+            "tags": { "pageInfo": { "hasNextPage": false }, "nodes": [{ "name": "v1.0.0", "target": { "oid": "c1" } }] },
+            "branches": { "pageInfo": { "hasNextPage": false }, "nodes": [] }
+          },
+          {
+            "name": "archived",
+            "isArchived": true,
+            "owner": { "login": "example" },
+            "defaultBranchRef": {
+              "name": "main",
+              "target": { "history": { "pageInfo": { "hasNextPage": false }, "nodes": [{ "oid": "c1" }] } }
+            },
+            "tags": { "pageInfo": { "hasNextPage": false }, "nodes": [] },
+            "branches": { "pageInfo": { "hasNextPage": false }, "nodes": [] }
+          }
+        ]
+      }
+    }
+  }
+}`
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	gh, err := New().Token("token").Discovery(GraphQLDiscovery).Build()
+	require.NoError(t, err)
+
+	gh.client.BaseURL, err = url.Parse(server.URL + "/")
+	require.NoError(t, err)
+
+	gh.client.UploadURL = gh.client.BaseURL
+
+	gh.graphqlURL = server.URL + "/graphql"
+	return gh
+}
+
+func TestGraphQLReleasableRepos(t *testing.T) {
+	gh := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/graphql":
+			_, _ = w.Write([]byte(graphqlRepositoriesFixture))
+		case "/repos/example/example1/compare/c2...main":
+			_, _ = w.Write([]byte(`{"status": "ahead", "ahead_by": 1, "files": [{"additions": 3, "deletions": 1}, {"additions": 2}]}`))
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+	})
+
+	gh.fileStats = true
+
+	channel, callback := gh.ReleasableReposByOrg(context.Background(), "example", "")
+
+	errs := make(chan error, 1)
+	go func() { errs <- callback() }()
+
+	var repos []*ReleaseableRepoResponse
+	for repo := range channel {
+		repos = append(repos, repo)
+	}
+
+	require.NoError(t, <-errs)
+	require.Len(t, repos, 1)
+
+	repo := repos[0]
+	assert.Equal(t, "example1", repo.Repo.GetName())
+	assert.Equal(t, "main", repo.Branch)
+	assert.Equal(t, "v1.1.0", repo.LatestTag.GetName())
+	assert.Equal(t, "v2.0.0", repo.HighestTag.GetName())
+	require.Len(t, repo.Commits, 1)
+	assert.Equal(t, "c3", repo.Commits[0].GetSHA())
+	assert.Equal(t, "octocat", repo.Commits[0].GetAuthor().GetLogin())
+	assert.Equal(t, "feat: three", repo.Commits[0].GetCommit().GetMessage())
+	assert.Equal(t, 1, repo.AheadBy)
+	assert.Equal(t, 2, repo.FilesChanged)
+	assert.Equal(t, 5, repo.Additions)
+	assert.Equal(t, 1, repo.Deletions)
+}
+
+func TestGraphQLReleasableReposWithoutFileStats(t *testing.T) {
+	gh := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/graphql":
+			_, _ = w.Write([]byte(graphqlRepositoriesFixture))
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+	})
+
+	channel, callback := gh.ReleasableReposByOrg(context.Background(), "example", "")
+
+	errs := make(chan error, 1)
+	go func() { errs <- callback() }()
+
+	var repos []*ReleaseableRepoResponse
+	for repo := range channel {
+		repos = append(repos, repo)
+	}
+
+	require.NoError(t, <-errs)
+	require.Len(t, repos, 1)
+
+	assert.Equal(t, "v1.1.0", repos[0].LatestTag.GetName())
+	assert.Equal(t, 1, repos[0].AheadBy)
+	assert.Zero(t, repos[0].FilesChanged)
+}
+
+func TestGraphQLChanges(t *testing.T) {
+	tags := []*github.RepositoryTag{
+		{Name: github.String("v1.0.1"), Commit: &github.Commit{SHA: github.String("c2")}},
+		{Name: github.String("v1.1.0"), Commit: &github.Commit{SHA: github.String("c1")}},
+	}
+
+	tests := []struct {
+		name     string
+		tags     []*github.RepositoryTag
+		history  string
+		latest   string
+		commits  []string
+		complete bool
+	}{
+		{
+			name:     "Highest ancestor",
+			tags:     tags,
+			history:  `{ "pageInfo": { "hasNextPage": false }, "nodes": [{ "oid": "c3" }, { "oid": "c2" }, { "oid": "c1" }] }`,
+			latest:   "v1.1.0",
+			commits:  []string{"c3", "c2"},
+			complete: true,
+		},
+		{
+			name:     "Highest tag isn't an ancestor",
+			tags:     append(tags, &github.RepositoryTag{Name: github.String("v2.0.0"), Commit: &github.Commit{SHA: github.String("other")}}),
+			history:  `{ "pageInfo": { "hasNextPage": false }, "nodes": [{ "oid": "c3" }, { "oid": "c2" }] }`,
+			latest:   "v1.0.1",
+			commits:  []string{"c3"},
+			complete: true,
+		},
+		{
+			name:     "Untagged",
+			history:  `{ "pageInfo": { "hasNextPage": false }, "nodes": [{ "oid": "c2" }, { "oid": "c1" }] }`,
+			commits:  []string{"c2", "c1"},
+			complete: true,
+		},
+		{
+			name:    "Highest may be an ancestor past the history",
+			tags:    tags,
+			history: `{ "pageInfo": { "hasNextPage": true }, "nodes": [{ "oid": "c3" }, { "oid": "c2" }] }`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			gh, err := New().Token("token").Build()
+			require.NoError(t, err)
+
+			var ref graphqlBranchRef
+			require.NoError(t, json.Unmarshal([]byte(`{ "name": "main", "target": { "history": `+test.history+` } }`), &ref))
+
+			latest, commits, complete := gh.graphqlChanges("example", test.tags, &ref)
+			require.Equal(t, test.complete, complete)
+
+			assert.Equal(t, test.latest, latest.GetName())
+
+			var shas []string
+			for _, commit := range commits {
+				shas = append(shas, commit.GetSHA())
+			}
+
+			assert.Equal(t, test.commits, shas)
+		})
+	}
+}
+
+func TestGraphQLReleasableReposFallback(t *testing.T) {
+	var paths []string
+
+	gh := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+
+		switch r.URL.Path {
+		case "/graphql":
+			w.WriteHeader(http.StatusNotFound)
+		case "/orgs/example/repos":
+			_, _ = w.Write([]byte(`[{ "name": "archived", "archived": true }]`))
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+
+	channel, callback := gh.ReleasableReposByOrg(context.Background(), "example", "")
+
+	errs := make(chan error, 1)
+	go func() { errs <- callback() }()
+
+	for range channel {
+	}
+
+	require.NoError(t, <-errs)
+	assert.Equal(t, []string{"/graphql", "/orgs/example/repos"}, paths)
+}
+
+func TestGraphQLReleasableReposNoise(t *testing.T) {
+	gh := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/graphql":
+			_, _ = w.Write([]byte(graphqlRepositoriesFixture))
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+	})
+
+	gh.noise = &NoiseFilter{Authors: []string{"octocat"}}
+
+	channel, callback := gh.ReleasableReposByOrg(context.Background(), "example", "")
+
+	errs := make(chan error, 1)
+	go func() { errs <- callback() }()
+
+	var repos []*ReleaseableRepoResponse
+	for repo := range channel {
+		repos = append(repos, repo)
+	}
+
+	require.NoError(t, <-errs)
+	assert.Empty(t, repos)
+}
@@ -0,0 +1,157 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/google/go-github/v41/github"
+)
+
+// journalIDFormat identifies runs to the millisecond, runs are never saved over one another.
+const journalIDFormat = "20060102-150405.000"
+
+type EntryKind string
+
+const (
+	// CommitEntry a commit of version files that Branch was fast forwarded to from Parent.
+	CommitEntry EntryKind = "commit"
+	// TagEntry a tag created alongside a release.
+	TagEntry EntryKind = "tag"
+	// ReleaseEntry a GitHub release.
+	ReleaseEntry EntryKind = "release"
+	// BranchEntry a release branch.
+	BranchEntry EntryKind = "branch"
+)
+
+// Entry something created in a repository during a run.
+type Entry struct {
+	Kind  EntryKind `yaml:"kind" json:"kind"`
+	Owner string    `yaml:"owner" json:"owner"`
+	Repo  string    `yaml:"repo" json:"repo"`
+	// ID of the release.
+	ID int64 `yaml:"id,omitempty" json:"id,omitempty"`
+	// Ref the name of the tag or branch.
+	Ref string `yaml:"ref,omitempty" json:"ref,omitempty"`
+	// SHA the created commit.
+	SHA string `yaml:"sha,omitempty" json:"sha,omitempty"`
+	// Parent the commit the branch pointed to prior to the created commit.
+	Parent string `yaml:"parent,omitempty" json:"parent,omitempty"`
+}
+
+func (e *Entry) String() string {
+	switch e.Kind {
+	case CommitEntry:
+		return fmt.Sprintf("%s/%s commit %s on %s", e.Owner, e.Repo, e.SHA, e.Ref)
+	case ReleaseEntry:
+		return fmt.Sprintf("%s/%s release %d", e.Owner, e.Repo, e.ID)
+	default:
+		return fmt.Sprintf("%s/%s %s %s", e.Owner, e.Repo, e.Kind, e.Ref)
+	}
+}
+
+// Journal records everything created during a run, so the run can be rolled back.
+type Journal struct {
+	ID      string    `yaml:"id" json:"id"`
+	Host    string    `yaml:"host" json:"host"`
+	Created time.Time `yaml:"created" json:"created"`
+	Entries []*Entry  `yaml:"entries" json:"entries"`
+
+	mu sync.Mutex
+}
+
+// NewJournal creates an empty Journal for a run against host identified by the current time.
+func NewJournal(host string) *Journal {
+	now := time.Now()
+	return &Journal{ID: now.Format(journalIDFormat), Host: host, Created: now}
+}
+
+// Record adds entry to the journal, does nothing when the journal is nil.
+func (j *Journal) Record(entry *Entry) {
+	if j == nil {
+		return
+	}
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	j.Entries = append(j.Entries, entry)
+}
+
+// Append records all entries of other.
+func (j *Journal) Append(other *Journal) {
+	for _, entry := range other.Entries {
+		j.Record(entry)
+	}
+}
+
+// Rollback deletes everything recorded in the journal in reverse order. Entries that were rolled back are removed from
+// the journal, returns an error for every entry that couldn't be rolled back.
+func (gh *Client) Rollback(ctx context.Context, j *Journal) []error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	var errs []error
+	var remaining []*Entry
+
+	for i := len(j.Entries) - 1; i >= 0; i-- {
+		entry := j.Entries[i]
+
+		if err := gh.rollbackEntry(ctx, entry); err != nil {
+			errs = append(errs, fmt.Errorf("failed to roll back %s: %v", entry, err))
+			remaining = append([]*Entry{entry}, remaining...)
+		}
+	}
+
+	j.Entries = remaining
+	return errs
+}
+
+func (gh *Client) rollbackEntry(ctx context.Context, entry *Entry) error {
+	switch entry.Kind {
+	case ReleaseEntry:
+		r, err := gh.client.Repositories.DeleteRelease(ctx, entry.Owner, entry.Repo, entry.ID)
+		return ignoreNotFound(r, err)
+	case TagEntry:
+		r, err := gh.client.Git.DeleteRef(ctx, entry.Owner, entry.Repo, "tags/"+entry.Ref)
+		return ignoreNotFound(r, err)
+	case BranchEntry:
+		r, err := gh.client.Git.DeleteRef(ctx, entry.Owner, entry.Repo, "heads/"+entry.Ref)
+		return ignoreNotFound(r, err)
+	case CommitEntry:
+		ref, r, err := gh.client.Git.GetRef(ctx, entry.Owner, entry.Repo, "heads/"+entry.Ref)
+		if err != nil {
+			return err
+		}
+
+		if err := github.CheckResponse(r.Response); err != nil {
+			return err
+		}
+
+		// Never discard commits pushed after the release
+		if sha := ref.GetObject().GetSHA(); sha != entry.SHA {
+			return fmt.Errorf("branch %s has moved to %s, revert %s manually", entry.Ref, sha, entry.SHA)
+		}
+
+		ref.Object = &github.GitObject{SHA: github.String(entry.Parent)}
+		_, r, err = gh.client.Git.UpdateRef(ctx, entry.Owner, entry.Repo, ref, true)
+		if err != nil {
+			return err
+		}
+
+		return github.CheckResponse(r.Response)
+	default:
+		return fmt.Errorf("unknown kind '%s'", entry.Kind)
+	}
+}
+
+// ignoreNotFound treats something that no longer exists as rolled back.
+func ignoreNotFound(r *github.Response, err error) error {
+	if r != nil && r.StatusCode == http.StatusNotFound {
+		return nil
+	}
+
+	return err
+}
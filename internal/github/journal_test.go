@@ -0,0 +1,123 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRollback(t *testing.T) {
+	var mu sync.Mutex
+	var requests []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		mu.Unlock()
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/repos/example/moved/git/ref/heads/main":
+			_, _ = w.Write([]byte(`{"ref": "refs/heads/main", "object": {"sha": "other"}}`))
+		case r.Method == http.MethodGet:
+			_, _ = w.Write([]byte(`{"ref": "refs/heads/main", "object": {"sha": "def456"}}`))
+		case r.Method == http.MethodPatch:
+			_, _ = w.Write([]byte(`{"ref": "refs/heads/main", "object": {"sha": "abc123"}}`))
+		case r.URL.Path == "/repos/example/example1/git/refs/heads/v1.3.0":
+			// Already deleted
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer server.Close()
+
+	gh, err := New().Token("token").Build()
+	require.NoError(t, err)
+
+	gh.client.BaseURL, err = url.Parse(server.URL + "/")
+	require.NoError(t, err)
+
+	moved := &Entry{Kind: CommitEntry, Owner: "example", Repo: "moved", Ref: "main", SHA: "def456", Parent: "abc123"}
+
+	journal := &Journal{Entries: []*Entry{
+		moved,
+		{Kind: CommitEntry, Owner: "example", Repo: "example1", Ref: "main", SHA: "def456", Parent: "abc123"},
+		{Kind: TagEntry, Owner: "example", Repo: "example1", Ref: "v1.3.0"},
+		{Kind: ReleaseEntry, Owner: "example", Repo: "example1", ID: 42},
+		{Kind: BranchEntry, Owner: "example", Repo: "example1", Ref: "v1.3.0"},
+	}}
+
+	errs := gh.Rollback(context.Background(), journal)
+
+	assert.Len(t, errs, 1)
+	assert.Equal(t, []*Entry{moved}, journal.Entries)
+	assert.Equal(t, []string{
+		"DELETE /repos/example/example1/git/refs/heads/v1.3.0",
+		"DELETE /repos/example/example1/releases/42",
+		"DELETE /repos/example/example1/git/refs/tags/v1.3.0",
+		"GET /repos/example/example1/git/ref/heads/main",
+		"PATCH /repos/example/example1/git/refs/heads/main",
+		"GET /repos/example/moved/git/ref/heads/main",
+	}, requests)
+}
+
+func TestCreateReleaseJournal(t *testing.T) {
+	tests := []struct {
+		name      string
+		tagExists bool
+		expected  []*Entry
+	}{
+		{
+			name: "New tag",
+			expected: []*Entry{
+				{Kind: TagEntry, Owner: "example", Repo: "example1", Ref: "v1.1.0"},
+				{Kind: ReleaseEntry, Owner: "example", Repo: "example1", ID: 1},
+			},
+		},
+		{
+			name:      "Existing tag",
+			tagExists: true,
+			expected: []*Entry{
+				{Kind: ReleaseEntry, Owner: "example", Repo: "example1", ID: 1},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			gh := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/repos/example/example1/git/ref/tags/v1.1.0":
+					if !test.tagExists {
+						w.WriteHeader(http.StatusNotFound)
+						return
+					}
+
+					_, _ = w.Write([]byte(`{"ref": "refs/tags/v1.1.0", "object": {"sha": "c1"}}`))
+				case "/repos/example/example1/releases":
+					if r.Method == http.MethodGet {
+						_, _ = w.Write([]byte(`[]`))
+						return
+					}
+
+					_, _ = w.Write([]byte(`{"id": 1}`))
+				default:
+					t.Errorf("unexpected request %s", r.URL.Path)
+				}
+			})
+
+			journal := &Journal{}
+			release := &RepositoryRelease{Owner: "example", Name: "example1", Version: "v1.1.0", TargetSHA: "c1", Branch: "main"}
+
+			_, err := gh.createRelease(context.Background(), "example", release, false, journal)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, journal.Entries)
+		})
+	}
+}
@@ -0,0 +1,190 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/google/go-github/v41/github"
+)
+
+// NoiseFilter discounts commits that don't warrant a release on their own, e.g. dependency bumps by bots or changes to
+// CI. Repositories with only noise since their latest tag aren't releasable.
+//
+// Authors are logins or names, or regular expressions between slashes (/\[bot\]$/). Messages are regular expressions
+// of commit messages. Paths are glob patterns where ** matches any directories (.github/**) and patterns without a
+// slash match file names in any directory (*.md), a commit is noise when all of its files match.
+type NoiseFilter struct {
+	Authors  []string
+	Messages []string
+	Paths    []string
+}
+
+// Validate the author, message and path patterns of the filter.
+func (f *NoiseFilter) Validate() error {
+	for _, author := range f.Authors {
+		if _, err := matchAuthor(author, ""); err != nil {
+			return fmt.Errorf("invalid author pattern '%s': %v", author, err)
+		}
+	}
+
+	for _, message := range f.Messages {
+		if _, err := regexp.Compile(message); err != nil {
+			return fmt.Errorf("invalid message pattern '%s': %v", message, err)
+		}
+	}
+
+	for _, pattern := range f.Paths {
+		for _, segment := range strings.Split(pattern, "/") {
+			if _, err := path.Match(segment, ""); err != nil {
+				return fmt.Errorf("invalid path pattern '%s': %v", pattern, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// IsEmpty whether the filter has no rules, so no commit is noise.
+func (f *NoiseFilter) IsEmpty() bool {
+	return len(f.Authors) == 0 && len(f.Messages) == 0 && len(f.Paths) == 0
+}
+
+// matchesCommit whether commit is noise by its author or message, paths require the files of the commit.
+func (f *NoiseFilter) matchesCommit(commit *github.RepositoryCommit) bool {
+	login, name := commit.GetAuthor().GetLogin(), commit.GetCommit().GetAuthor().GetName()
+	for _, author := range f.Authors {
+		// Validated when loaded
+		if ok, _ := matchAuthor(author, login); ok && login != "" {
+			return true
+		}
+
+		if ok, _ := matchAuthor(author, name); ok && name != "" {
+			return true
+		}
+	}
+
+	message := commit.GetCommit().GetMessage()
+	for _, pattern := range f.Messages {
+		if ok, _ := regexp.MatchString(pattern, message); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchesFiles whether every file is noise, commits without files aren't noise.
+func (f *NoiseFilter) matchesFiles(files []*github.CommitFile) bool {
+	if len(f.Paths) == 0 || len(files) == 0 {
+		return false
+	}
+
+	for _, file := range files {
+		if !f.matchesPath(file.GetFilename()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (f *NoiseFilter) matchesPath(name string) bool {
+	for _, pattern := range f.Paths {
+		// Validated when loaded
+		if matchPath(pattern, name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// noiseCommits the SHAs of the commits that are noise, the files of a commit are only retrieved when its author and message
+// aren't noise.
+func (gh *Client) noiseCommits(ctx context.Context, owner, repo string, commits []*github.RepositoryCommit) (map[string]bool, error) {
+	if gh.noise.IsEmpty() {
+		return nil, nil
+	}
+
+	noise := make(map[string]bool)
+	for _, commit := range commits {
+		if gh.noise.matchesCommit(commit) {
+			noise[commit.GetSHA()] = true
+			continue
+		}
+
+		if len(gh.noise.Paths) == 0 {
+			continue
+		}
+
+		detailed, r, err := gh.client.Repositories.GetCommit(ctx, owner, repo, commit.GetSHA(), &github.ListOptions{PerPage: githubMaxPerPage})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get files of %s/%s@%s: %v", owner, repo, commit.GetSHA(), err)
+		}
+
+		if err := github.CheckResponse(r.Response); err != nil {
+			return nil, fmt.Errorf("failed to get files of %s/%s@%s: %v", owner, repo, commit.GetSHA(), err)
+		}
+
+		if gh.noise.matchesFiles(detailed.Files) {
+			noise[commit.GetSHA()] = true
+		}
+	}
+
+	return noise, nil
+}
+
+// matchAuthor whether author is a login or name case insensitively, or matches a regular expression between slashes.
+func matchAuthor(pattern, author string) (bool, error) {
+	if len(pattern) > 1 && strings.HasPrefix(pattern, "/") && strings.HasSuffix(pattern, "/") {
+		re, err := regexp.Compile(pattern[1 : len(pattern)-1])
+		if err != nil {
+			return false, err
+		}
+
+		return re.MatchString(author), nil
+	}
+
+	return strings.EqualFold(pattern, author), nil
+}
+
+// matchPath whether name matches a glob pattern where ** matches any directories, patterns without a slash match the
+// base name of name.
+func matchPath(pattern, name string) bool {
+	if !strings.Contains(pattern, "/") {
+		ok, _ := path.Match(pattern, path.Base(name))
+		return ok
+	}
+
+	return matchSegments(strings.Split(strings.Trim(pattern, "/"), "/"), strings.Split(name, "/"))
+}
+
+func matchSegments(patterns, segments []string) bool {
+	if len(patterns) == 0 {
+		return len(segments) == 0
+	}
+
+	if patterns[0] == "**" {
+		// ** matches zero or more directories
+		for i := 0; i <= len(segments); i++ {
+			if matchSegments(patterns[1:], segments[i:]) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	if len(segments) == 0 {
+		return false
+	}
+
+	if ok, _ := path.Match(patterns[0], segments[0]); !ok {
+		return false
+	}
+
+	return matchSegments(patterns[1:], segments[1:])
+}
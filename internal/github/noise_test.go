@@ -0,0 +1,109 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v41/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchPath(t *testing.T) {
+	tests := []struct {
+		pattern  string
+		name     string
+		expected bool
+	}{
+		{pattern: ".github/**", name: ".github/workflows/ci.yml", expected: true},
+		{pattern: ".github/**", name: "src/.github/ci.yml", expected: false},
+		{pattern: "docs/**", name: "docs/index.md", expected: true},
+		{pattern: "**/testdata/**", name: "internal/version/testdata/tags.json", expected: true},
+		{pattern: "**/testdata/**", name: "testdata/tags.json", expected: true},
+		{pattern: "docs/*.md", name: "docs/guides/index.md", expected: false},
+		{pattern: "*.md", name: "docs/guides/index.md", expected: true},
+		{pattern: "*.md", name: "main.go", expected: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.pattern+" "+test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, matchPath(test.pattern, test.name))
+		})
+	}
+}
+
+func TestNoiseFilterMatchesCommit(t *testing.T) {
+	bump := &github.RepositoryCommit{
+		Author: &github.User{Login: github.String("dependabot[bot]")},
+		Commit: &github.Commit{Message: github.String("chore(deps): bump golang.org/x/sync"), Author: &github.CommitAuthor{Name: github.String("dependabot[bot]")}},
+	}
+
+	feature := &github.RepositoryCommit{
+		Author: &github.User{Login: github.String("octocat")},
+		Commit: &github.Commit{Message: github.String("feat: add teams"), Author: &github.CommitAuthor{Name: github.String("The Octocat")}},
+	}
+
+	tests := []struct {
+		name     string
+		filter   NoiseFilter
+		expected []bool
+	}{
+		{name: "Empty", filter: NoiseFilter{}, expected: []bool{false, false}},
+		{name: "Author login", filter: NoiseFilter{Authors: []string{"Dependabot[bot]"}}, expected: []bool{true, false}},
+		{name: "Author name", filter: NoiseFilter{Authors: []string{"the octocat"}}, expected: []bool{false, true}},
+		{name: "Author regex", filter: NoiseFilter{Authors: []string{`/\[bot\]$/`}}, expected: []bool{true, false}},
+		{name: "Message", filter: NoiseFilter{Messages: []string{`^chore\(deps\)`}}, expected: []bool{true, false}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected[0], test.filter.matchesCommit(bump))
+			assert.Equal(t, test.expected[1], test.filter.matchesCommit(feature))
+		})
+	}
+}
+
+func TestNoiseFilterValidate(t *testing.T) {
+	assert.NoError(t, (&NoiseFilter{Authors: []string{"dependabot[bot]", `/\[bot\]$/`}, Messages: []string{"^chore"}, Paths: []string{".github/**", "*.md"}}).Validate())
+
+	assert.Error(t, (&NoiseFilter{Authors: []string{"/(/"}}).Validate())
+	assert.Error(t, (&NoiseFilter{Messages: []string{"("}}).Validate())
+	assert.Error(t, (&NoiseFilter{Paths: []string{"docs/[/*.md"}}).Validate())
+}
+
+func TestNoiseCommits(t *testing.T) {
+	gh := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/example/example1/commits/c1":
+			_, _ = w.Write([]byte(`{"sha": "c1", "files": [{"filename": ".github/workflows/ci.yml"}, {"filename": "README.md"}]}`))
+		case "/repos/example/example1/commits/c2":
+			_, _ = w.Write([]byte(`{"sha": "c2", "files": [{"filename": ".github/workflows/ci.yml"}, {"filename": "main.go"}]}`))
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+	})
+
+	gh.noise = &NoiseFilter{Authors: []string{"dependabot[bot]"}, Paths: []string{".github/**", "*.md"}}
+
+	commits := []*github.RepositoryCommit{
+		{SHA: github.String("c1")},
+		{SHA: github.String("c2")},
+		// Noise by its author without retrieving its files
+		{SHA: github.String("c3"), Author: &github.User{Login: github.String("dependabot[bot]")}},
+	}
+
+	noise, err := gh.noiseCommits(context.Background(), "example", "example1", commits)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]bool{"c1": true, "c3": true}, noise)
+}
+
+func TestNoiseCommitsEmpty(t *testing.T) {
+	gh := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s", r.URL.Path)
+	})
+
+	noise, err := gh.noiseCommits(context.Background(), "example", "example1", []*github.RepositoryCommit{{SHA: github.String("c1")}})
+	require.NoError(t, err)
+	assert.Empty(t, noise)
+}
@@ -0,0 +1,39 @@
+package github
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-github/v41/github"
+)
+
+// GenerateNotes release notes of tag generated by GitHub from the changes since previousTag, following the categories
+// of the repository's .github/release.yml. When previousTag is empty GitHub picks the previous release.
+func (gh *Client) GenerateNotes(ctx context.Context, owner, repo, tag, previousTag, target string) (string, error) {
+	options := &github.GenerateNotesOptions{TagName: tag}
+	if previousTag != "" {
+		options.PreviousTagName = github.String(previousTag)
+	}
+
+	if target != "" {
+		options.TargetCommitish = github.String(target)
+	}
+
+	var notes *github.RepositoryReleaseNotes
+	err := gh.reads.Do(ctx, func() error {
+		var r *github.Response
+		var err error
+
+		notes, r, err = gh.client.Repositories.GenerateReleaseNotes(ctx, owner, repo, options)
+		if err != nil {
+			return err
+		}
+
+		return github.CheckResponse(r.Response)
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to generate release notes for %s/%s: %v", owner, repo, err)
+	}
+
+	return notes.Body, nil
+}
@@ -0,0 +1,42 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateNotes(t *testing.T) {
+	gh := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/repos/example/example1/releases/generate-notes" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		var body map[string]string
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+		expected := map[string]string{"tag_name": "v1.1.0", "previous_tag_name": "v1.0.0", "target_commitish": "c1"}
+		assert.Equal(t, expected, body)
+
+		_, _ = w.Write([]byte(`{"name": "v1.1.0", "body": "## What's Changed"}`))
+	})
+
+	notes, err := gh.GenerateNotes(context.Background(), "example", "example1", "v1.1.0", "v1.0.0", "c1")
+	require.NoError(t, err)
+	assert.Equal(t, "## What's Changed", notes)
+}
+
+func TestGenerateNotesError(t *testing.T) {
+	gh := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		_, _ = w.Write([]byte(`{"message": "Validation Failed"}`))
+	})
+
+	_, err := gh.GenerateNotes(context.Background(), "example", "example1", "v1.1.0", "", "")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "example/example1")
+}
@@ -0,0 +1,31 @@
+package github
+
+import (
+	"context"
+
+	"golang.org/x/sync/semaphore"
+)
+
+const (
+	defaultReadConcurrency  = 10
+	defaultWriteConcurrency = 3
+)
+
+// pool limits how many calls run concurrently, shared by every caller of a Client.
+type pool struct {
+	sem *semaphore.Weighted
+}
+
+func newPool(limit int) *pool {
+	return &pool{sem: semaphore.NewWeighted(int64(limit))}
+}
+
+// Do runs f once a worker is available, returns the error of ctx when ctx is done before a worker is available.
+func (p *pool) Do(ctx context.Context, f func() error) error {
+	if err := p.sem.Acquire(ctx, 1); err != nil {
+		return err
+	}
+	defer p.sem.Release(1)
+
+	return f()
+}
@@ -0,0 +1,76 @@
+package github
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPoolDo(t *testing.T) {
+	p := newPool(2)
+
+	var running, max int32
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			err := p.Do(context.Background(), func() error {
+				current := atomic.AddInt32(&running, 1)
+				defer atomic.AddInt32(&running, -1)
+
+				for {
+					previous := atomic.LoadInt32(&max)
+					if current <= previous || atomic.CompareAndSwapInt32(&max, previous, current) {
+						break
+					}
+				}
+
+				time.Sleep(time.Millisecond)
+				return nil
+			})
+			assert.NoError(t, err)
+		}()
+	}
+
+	wg.Wait()
+	assert.LessOrEqual(t, max, int32(2))
+}
+
+func TestPoolDoCanceled(t *testing.T) {
+	p := newPool(1)
+
+	release := make(chan struct{})
+	go func() {
+		_ = p.Do(context.Background(), func() error {
+			<-release
+			return nil
+		})
+	}()
+
+	// Wait for the only worker to be taken
+	for p.sem.TryAcquire(1) {
+		p.sem.Release(1)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	called := false
+	err := p.Do(ctx, func() error {
+		called = true
+		return nil
+	})
+
+	close(release)
+
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.False(t, called)
+}
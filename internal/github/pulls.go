@@ -0,0 +1,80 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/google/go-github/v41/github"
+	"golang.org/x/sync/errgroup"
+)
+
+// PullRequests the merged pull request associated with each commit by SHA, commits without one are omitted.
+func (gh *Client) PullRequests(ctx context.Context, owner, repo string, commits []*github.RepositoryCommit) (map[string]*github.PullRequest, error) {
+	var mu sync.Mutex
+	pullRequests := make(map[string]*github.PullRequest)
+
+	errGrp, ctx := errgroup.WithContext(ctx)
+
+	for _, commit := range commits {
+		sha := commit.GetSHA()
+
+		errGrp.Go(func() error {
+			var pullRequest *github.PullRequest
+
+			err := gh.reads.Do(ctx, func() (err error) {
+				pullRequest, err = gh.pullRequest(ctx, owner, repo, sha)
+				return err
+			})
+			if err != nil {
+				return err
+			}
+
+			if pullRequest == nil {
+				return nil
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			pullRequests[sha] = pullRequest
+			return nil
+		})
+	}
+
+	if err := errGrp.Wait(); err != nil {
+		return nil, err
+	}
+
+	return pullRequests, nil
+}
+
+// pullRequest the merged pull request of commit sha, preferring the one it's the merge commit of over those it was
+// merged by, nil when there is none.
+func (gh *Client) pullRequest(ctx context.Context, owner, repo, sha string) (*github.PullRequest, error) {
+	pullRequests, r, err := gh.client.PullRequests.ListPullRequestsWithCommit(ctx, owner, repo, sha, &github.PullRequestListOptions{State: "closed"})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pull requests of %s/%s@%s: %v", owner, repo, sha, err)
+	}
+
+	if err := github.CheckResponse(r.Response); err != nil {
+		return nil, fmt.Errorf("failed to list pull requests of %s/%s@%s: %v", owner, repo, sha, err)
+	}
+
+	var merged *github.PullRequest
+	for _, pullRequest := range pullRequests {
+		if pullRequest.MergedAt == nil {
+			continue
+		}
+
+		if pullRequest.GetMergeCommitSHA() == sha {
+			return pullRequest, nil
+		}
+
+		if merged == nil {
+			merged = pullRequest
+		}
+	}
+
+	return merged, nil
+}
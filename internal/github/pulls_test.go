@@ -0,0 +1,47 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v41/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPullRequests(t *testing.T) {
+	gh := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/example/example1/commits/c1/pulls":
+			// Commit of a branch that was merged, and later squashed into an unmerged pull request
+			_, _ = w.Write([]byte(`[{"number": 2}, {"number": 1, "merged_at": "2022-01-31T12:00:00Z", "merge_commit_sha": "c3"}]`))
+		case "/repos/example/example1/commits/c2/pulls":
+			_, _ = w.Write([]byte(`[{"number": 3, "merged_at": "2022-01-31T12:00:00Z", "merge_commit_sha": "c3"}, {"number": 4, "merged_at": "2022-01-31T12:00:00Z", "merge_commit_sha": "c2"}]`))
+		case "/repos/example/example1/commits/c3/pulls":
+			_, _ = w.Write([]byte(`[]`))
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+	})
+
+	commits := []*github.RepositoryCommit{{SHA: github.String("c1")}, {SHA: github.String("c2")}, {SHA: github.String("c3")}}
+
+	pullRequests, err := gh.PullRequests(context.Background(), "example", "example1", commits)
+	require.NoError(t, err)
+
+	require.Len(t, pullRequests, 2)
+	assert.Equal(t, 1, pullRequests["c1"].GetNumber())
+	assert.Equal(t, 4, pullRequests["c2"].GetNumber())
+}
+
+func TestPullRequestsError(t *testing.T) {
+	gh := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	commits := []*github.RepositoryCommit{{SHA: github.String("c1")}}
+
+	_, err := gh.PullRequests(context.Background(), "example", "example1", commits)
+	assert.Error(t, err)
+}
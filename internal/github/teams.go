@@ -0,0 +1,133 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/google/go-github/v41/github"
+	"golang.org/x/sync/errgroup"
+)
+
+// Team a GitHub team of an organization.
+type Team struct {
+	Slug        string
+	Name        string
+	Description string
+}
+
+// Teams all teams of org visible to the authenticated user.
+func (gh *Client) Teams(ctx context.Context, org string) ([]*Team, error) {
+	next := 1
+
+	var teams []*Team
+	for {
+		options := &github.ListOptions{Page: next, PerPage: githubMaxPerPage}
+
+		var page []*github.Team
+		var r *github.Response
+
+		err := gh.reads.Do(ctx, func() (err error) {
+			page, r, err = gh.client.Teams.ListTeams(ctx, org, options)
+			return err
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list teams for %s: %v", org, err)
+		}
+
+		if err := github.CheckResponse(r.Response); err != nil {
+			return nil, fmt.Errorf("failed to list teams for %s: %v", org, err)
+		}
+
+		for _, team := range page {
+			teams = append(teams, &Team{Slug: team.GetSlug(), Name: team.GetName(), Description: team.GetDescription()})
+		}
+
+		next = r.NextPage
+		if next == 0 {
+			break
+		}
+	}
+
+	return teams, nil
+}
+
+// ReleasableReposByTeam async retrieval of the releasable repositories of the team slug of org, the repositories of
+// the team are always listed with REST regardless of the discovery.
+func (gh *Client) ReleasableReposByTeam(ctx context.Context, org, slug, branch string) (<-chan *ReleaseableRepoResponse, func() error) {
+	c := make(chan *ReleaseableRepoResponse)
+
+	errGrp, ctx := errgroup.WithContext(ctx)
+
+	return c, func() error {
+		defer close(c)
+
+		repos, err := gh.teamRepos(ctx, org, slug)
+		if err != nil {
+			return err
+		}
+
+		total := int32(len(repos))
+
+		for _, repo := range repos {
+			repo := repo
+
+			errGrp.Go(func() error {
+				var releaseableRepo *ReleaseableRepoResponse
+
+				err := gh.reads.Do(ctx, func() (err error) {
+					releaseableRepo, err = gh.ReleaseableRepo(ctx, org, repo, branch)
+					return err
+				})
+				if err != nil {
+					return err
+				}
+
+				if releaseableRepo == nil {
+					atomic.AddInt32(&total, -1)
+					return nil
+				}
+
+				releaseableRepo.Total = atomic.LoadInt32(&total)
+				c <- releaseableRepo
+				return nil
+			})
+		}
+
+		return errGrp.Wait()
+	}
+}
+
+// teamRepos the repositories of the team slug of org.
+func (gh *Client) teamRepos(ctx context.Context, org, slug string) ([]*github.Repository, error) {
+	next := 1
+
+	var repos []*github.Repository
+	for {
+		options := &github.ListOptions{Page: next, PerPage: githubMaxPerPage}
+
+		var page []*github.Repository
+		var r *github.Response
+
+		err := gh.reads.Do(ctx, func() (err error) {
+			page, r, err = gh.client.Teams.ListTeamReposBySlug(ctx, org, slug, options)
+			return err
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list repositories of team %s/%s: %v", org, slug, err)
+		}
+
+		if err := github.CheckResponse(r.Response); err != nil {
+			return nil, fmt.Errorf("failed to list repositories of team %s/%s: %v", org, slug, err)
+		}
+
+		repos = append(repos, page...)
+
+		next = r.NextPage
+		if next == 0 {
+			break
+		}
+	}
+
+	return repos, nil
+}
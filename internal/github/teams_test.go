@@ -0,0 +1,72 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTeams(t *testing.T) {
+	var server string
+	gh := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/orgs/example/teams" {
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+
+		switch r.URL.Query().Get("page") {
+		case "2":
+			_, _ = w.Write([]byte(`[{"slug": "squad-b", "name": "Squad B"}]`))
+		default:
+			w.Header().Set("Link", fmt.Sprintf(`<%s/orgs/example/teams?page=2>; rel="next"`, server))
+			_, _ = w.Write([]byte(`[{"slug": "squad-a", "name": "Squad A", "description": "Payments"}]`))
+		}
+	})
+	server = gh.client.BaseURL.String()
+
+	teams, err := gh.Teams(context.Background(), "example")
+	require.NoError(t, err)
+
+	expected := []*Team{
+		{Slug: "squad-a", Name: "Squad A", Description: "Payments"},
+		{Slug: "squad-b", Name: "Squad B"},
+	}
+	assert.Equal(t, expected, teams)
+}
+
+func TestReleasableReposByTeam(t *testing.T) {
+	gh := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/orgs/example/teams/squad-a/repos":
+			_, _ = w.Write([]byte(`[{"name": "example1", "default_branch": "main"}, {"name": "archived", "archived": true}]`))
+		case "/repos/example/example1/tags":
+			_, _ = w.Write([]byte(`[]`))
+		case "/repos/example/example1/commits":
+			_, _ = w.Write([]byte(`[{"sha": "c1"}]`))
+		case "/repos/example/example1/branches":
+			_, _ = w.Write([]byte(`[{"name": "main"}]`))
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+	})
+
+	channel, callback := gh.ReleasableReposByTeam(context.Background(), "example", "squad-a", "")
+
+	errc := make(chan error, 1)
+	go func() { errc <- callback() }()
+
+	var names []string
+	for repo := range channel {
+		names = append(names, repo.Repo.GetName())
+		assert.Equal(t, int32(1), repo.Total)
+	}
+
+	require.NoError(t, <-errc)
+
+	sort.Strings(names)
+	assert.Equal(t, []string{"example1"}, names)
+}
@@ -0,0 +1,211 @@
+package github
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultMaxRetries = 5
+	defaultBackoff    = time.Second
+	// secondaryBackoff GitHub recommends waiting at least a minute after a secondary rate limit without Retry-After.
+	secondaryBackoff = time.Minute
+
+	headerRateLimit     = "X-RateLimit-Limit"
+	headerRateRemaining = "X-RateLimit-Remaining"
+	headerRateReset     = "X-RateLimit-Reset"
+	headerRetryAfter    = "Retry-After"
+)
+
+// Rate the rate limit budget of the latest response from GitHub.
+type Rate struct {
+	Limit     int
+	Remaining int
+	Reset     time.Time
+}
+
+// rateLimitTransport waits out primary and secondary rate limits and retries server errors of idempotent requests with
+// exponential backoff.
+//
+// Waits never outlast the deadline of the request's context, instead the response is returned as is.
+type rateLimitTransport struct {
+	base       http.RoundTripper
+	maxRetries int
+	backoff    time.Duration
+	now        func() time.Time
+
+	mu   sync.Mutex
+	rate Rate
+}
+
+func newRateLimitTransport(base http.RoundTripper) *rateLimitTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	return &rateLimitTransport{base: base, maxRetries: defaultMaxRetries, backoff: defaultBackoff, now: time.Now}
+}
+
+// Rate the rate limit budget of the latest response.
+func (t *rateLimitTransport) Rate() Rate {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.rate
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		resp, err := t.base.RoundTrip(req)
+		if err != nil {
+			return nil, err
+		}
+
+		t.updateRate(resp)
+
+		wait, retry, err := t.wait(req, resp, attempt)
+		if err != nil {
+			return nil, err
+		}
+
+		if (!retry && wait <= 0) || !t.fits(req.Context(), wait) {
+			return resp, nil
+		}
+
+		// Used the last request of the budget, wait for the budget to reset before the next request
+		if !retry {
+			_ = sleep(req.Context(), wait)
+			return resp, nil
+		}
+
+		// Requests with a body that can't be replayed can't be retried
+		if attempt >= t.maxRetries || (req.Body != nil && req.GetBody == nil) {
+			return resp, nil
+		}
+
+		resp.Body.Close()
+
+		if err := sleep(req.Context(), wait); err != nil {
+			return nil, err
+		}
+
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+	}
+}
+
+// wait how long to wait after resp and whether req should be retried afterwards.
+//
+// Rate limited requests weren't processed so are always retried, a server error may have been processed after all so
+// only idempotent requests are retried.
+func (t *rateLimitTransport) wait(req *http.Request, resp *http.Response, attempt int) (time.Duration, bool, error) {
+	backoff := t.backoff << attempt
+
+	switch {
+	case resp.StatusCode >= http.StatusInternalServerError && idempotent(req.Method):
+		return backoff, true, nil
+	case resp.StatusCode == http.StatusForbidden || resp.StatusCode == http.StatusTooManyRequests:
+		if retryAfter, err := strconv.Atoi(resp.Header.Get(headerRetryAfter)); err == nil {
+			return time.Duration(retryAfter) * time.Second, true, nil
+		}
+
+		if resp.Header.Get(headerRateRemaining) == "0" {
+			return t.untilReset(resp), true, nil
+		}
+
+		secondary, err := isSecondaryRateLimit(resp)
+		if err != nil || !secondary {
+			return 0, false, err
+		}
+
+		if backoff < secondaryBackoff {
+			backoff = secondaryBackoff
+		}
+
+		return backoff, true, nil
+	case resp.Header.Get(headerRateRemaining) == "0":
+		return t.untilReset(resp), false, nil
+	default:
+		return 0, false, nil
+	}
+}
+
+// idempotent whether repeating a request with method has the same effect as making it once.
+func idempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+func (t *rateLimitTransport) untilReset(resp *http.Response) time.Duration {
+	reset, err := strconv.ParseInt(resp.Header.Get(headerRateReset), 10, 64)
+	if err != nil {
+		return 0
+	}
+
+	return time.Unix(reset, 0).Sub(t.now())
+}
+
+// fits whether waiting ends before the deadline of ctx.
+func (t *rateLimitTransport) fits(ctx context.Context, wait time.Duration) bool {
+	deadline, ok := ctx.Deadline()
+	return !ok || t.now().Add(wait).Before(deadline)
+}
+
+func (t *rateLimitTransport) updateRate(resp *http.Response) {
+	limit, err := strconv.Atoi(resp.Header.Get(headerRateLimit))
+	if err != nil {
+		return
+	}
+
+	remaining, _ := strconv.Atoi(resp.Header.Get(headerRateRemaining))
+	reset, _ := strconv.ParseInt(resp.Header.Get(headerRateReset), 10, 64)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.rate = Rate{Limit: limit, Remaining: remaining, Reset: time.Unix(reset, 0)}
+}
+
+// isSecondaryRateLimit whether resp is a secondary rate limit, the body is restored to be read again.
+func isSecondaryRateLimit(resp *http.Response) (bool, error) {
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return false, err
+	}
+
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	message := strings.ToLower(string(body))
+	return strings.Contains(message, "secondary rate limit") || strings.Contains(message, "abuse"), nil
+}
+
+// sleep for d or until ctx is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,186 @@
+package github
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimitTransport(t *testing.T) {
+	// Already reset by the time it's retried
+	reset := strconv.FormatInt(time.Now().Unix(), 10)
+
+	tests := []struct {
+		name      string
+		method    string
+		responses []func(w http.ResponseWriter)
+		timeout   time.Duration
+		status    int
+		requests  int
+	}{
+		{
+			name:   "Server error is retried",
+			method: http.MethodGet,
+			responses: []func(w http.ResponseWriter){
+				func(w http.ResponseWriter) { w.WriteHeader(http.StatusBadGateway) },
+				func(w http.ResponseWriter) { w.WriteHeader(http.StatusOK) },
+			},
+			status:   http.StatusOK,
+			requests: 2,
+		},
+		{
+			name:   "Server error of an idempotent request with a body is retried",
+			method: http.MethodPut,
+			responses: []func(w http.ResponseWriter){
+				func(w http.ResponseWriter) { w.WriteHeader(http.StatusServiceUnavailable) },
+				func(w http.ResponseWriter) { w.WriteHeader(http.StatusOK) },
+			},
+			status:   http.StatusOK,
+			requests: 2,
+		},
+		{
+			name:   "Server error of a non-idempotent request isn't retried",
+			method: http.MethodPost,
+			responses: []func(w http.ResponseWriter){
+				func(w http.ResponseWriter) { w.WriteHeader(http.StatusBadGateway) },
+			},
+			status:   http.StatusBadGateway,
+			requests: 1,
+		},
+		{
+			name: "Secondary rate limit with Retry-After is retried",
+			responses: []func(w http.ResponseWriter){
+				func(w http.ResponseWriter) {
+					w.Header().Set(headerRetryAfter, "0")
+					w.WriteHeader(http.StatusForbidden)
+				},
+				func(w http.ResponseWriter) { w.WriteHeader(http.StatusOK) },
+			},
+			status:   http.StatusOK,
+			requests: 2,
+		},
+		{
+			name: "Primary rate limit is retried after reset",
+			responses: []func(w http.ResponseWriter){
+				func(w http.ResponseWriter) {
+					w.Header().Set(headerRateLimit, "5000")
+					w.Header().Set(headerRateRemaining, "0")
+					w.Header().Set(headerRateReset, reset)
+					w.WriteHeader(http.StatusForbidden)
+				},
+				func(w http.ResponseWriter) { w.WriteHeader(http.StatusOK) },
+			},
+			status:   http.StatusOK,
+			requests: 2,
+		},
+		{
+			name: "Secondary rate limit past the deadline isn't retried",
+			responses: []func(w http.ResponseWriter){
+				func(w http.ResponseWriter) {
+					w.WriteHeader(http.StatusForbidden)
+					_, _ = w.Write([]byte(`{"message": "You have exceeded a secondary rate limit"}`))
+				},
+			},
+			timeout:  time.Second,
+			status:   http.StatusForbidden,
+			requests: 1,
+		},
+		{
+			name: "Forbidden isn't retried",
+			responses: []func(w http.ResponseWriter){
+				func(w http.ResponseWriter) {
+					w.WriteHeader(http.StatusForbidden)
+					_, _ = w.Write([]byte(`{"message": "Resource not accessible by integration"}`))
+				},
+			},
+			status:   http.StatusForbidden,
+			requests: 1,
+		},
+		{
+			name:   "Server errors are retried at most maxRetries",
+			method: http.MethodGet,
+			responses: []func(w http.ResponseWriter){
+				func(w http.ResponseWriter) { w.WriteHeader(http.StatusInternalServerError) },
+				func(w http.ResponseWriter) { w.WriteHeader(http.StatusInternalServerError) },
+				func(w http.ResponseWriter) { w.WriteHeader(http.StatusInternalServerError) },
+			},
+			status:   http.StatusInternalServerError,
+			requests: 3,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var requests int
+			var bodies []string
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := ioutil.ReadAll(r.Body)
+				bodies = append(bodies, string(body))
+
+				test.responses[requests](w)
+				requests++
+			}))
+			defer server.Close()
+
+			transport := newRateLimitTransport(nil)
+			transport.backoff = time.Millisecond
+			transport.maxRetries = 2
+
+			ctx := context.Background()
+			if test.timeout != 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, test.timeout)
+				defer cancel()
+			}
+
+			// Rate limits are retried regardless of the method
+			method := test.method
+			if method == "" {
+				method = http.MethodPost
+			}
+
+			req, err := http.NewRequestWithContext(ctx, method, server.URL, strings.NewReader("body"))
+			require.NoError(t, err)
+
+			resp, err := transport.RoundTrip(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			assert.Equal(t, test.status, resp.StatusCode)
+			assert.Equal(t, test.requests, requests)
+
+			for _, body := range bodies {
+				assert.Equal(t, "body", body)
+			}
+		})
+	}
+}
+
+func TestRateLimitTransportRate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerRateLimit, "5000")
+		w.Header().Set(headerRateRemaining, "4999")
+		w.Header().Set(headerRateReset, "1643630400")
+	}))
+	defer server.Close()
+
+	transport := newRateLimitTransport(nil)
+
+	req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+
+	resp, err := transport.RoundTrip(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	assert.Equal(t, Rate{Limit: 5000, Remaining: 4999, Reset: time.Unix(1643630400, 0)}, transport.Rate())
+}
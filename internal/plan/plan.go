@@ -0,0 +1,72 @@
+package plan
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/NickHackman/releaser/internal/github"
+	"gopkg.in/yaml.v3"
+)
+
+// Plan releases to review prior to creating them. Written as JSON when the file has a .json extension, otherwise YAML.
+type Plan struct {
+	Host                string                      `yaml:"host" json:"host"`
+	CreateReleaseBranch bool                        `yaml:"create_release_branch" json:"create_release_branch"`
+	Releases            []*github.RepositoryRelease `yaml:"releases" json:"releases"`
+}
+
+func isJSON(path string) bool {
+	return strings.EqualFold(filepath.Ext(path), ".json")
+}
+
+// Read the Plan at path.
+func Read(path string) (*Plan, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read plan %s: %v", path, err)
+	}
+
+	var p Plan
+	if isJSON(path) {
+		err = json.Unmarshal(content, &p)
+	} else {
+		err = yaml.Unmarshal(content, &p)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse plan %s: %v", path, err)
+	}
+
+	for _, release := range p.Releases {
+		if release.Owner == "" {
+			return nil, fmt.Errorf("failed to parse plan %s: release %s is missing an owner", path, release.Name)
+		}
+	}
+
+	return &p, nil
+}
+
+// Write the Plan to path.
+func (p *Plan) Write(path string) error {
+	var content []byte
+	var err error
+
+	if isJSON(path) {
+		content, err = json.MarshalIndent(p, "", "  ")
+	} else {
+		content, err = yaml.Marshal(p)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to serialize plan: %v", err)
+	}
+
+	if err := ioutil.WriteFile(path, content, 0600); err != nil {
+		return fmt.Errorf("failed to write plan %s: %v", path, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package plan_test
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/NickHackman/releaser/internal/github"
+	"github.com/NickHackman/releaser/internal/plan"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteRead(t *testing.T) {
+	p := &plan.Plan{
+		Host:                "github.com",
+		CreateReleaseBranch: true,
+		Releases: []*github.RepositoryRelease{
+			{
+				Owner:       "example",
+				Name:        "example1",
+				Version:     "v1.3.0",
+				Change:      "minor",
+				Body:        "abcdef12 feat: example\n",
+				BaselineTag: "v1.2.0",
+				TargetSHA:   "abcdef1234567890",
+				Branch:      "main",
+				Files:       []github.VersionFile{{Path: "package.json", Key: "version"}},
+				Commits:     []github.Commit{{SHA: "abcdef1234567890", Summary: "feat: example"}},
+			},
+		},
+	}
+
+	tests := []struct {
+		name string
+		file string
+	}{
+		{name: "YAML", file: "releases.yaml"},
+		{name: "JSON", file: "releases.json"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), test.file)
+
+			require.NoError(t, p.Write(path))
+
+			read, err := plan.Read(path)
+			require.NoError(t, err)
+			assert.Equal(t, p, read)
+		})
+	}
+}
+
+func TestReadMissingOwner(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "releases.yaml")
+
+	content := "host: github.com\nreleases:\n  - owner: example\n    name: example1\n  - name: example2\n"
+	require.NoError(t, ioutil.WriteFile(path, []byte(content), 0600))
+
+	_, err := plan.Read(path)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "example2")
+}
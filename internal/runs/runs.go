@@ -0,0 +1,91 @@
+package runs
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/NickHackman/releaser/internal/github"
+	"gopkg.in/yaml.v3"
+)
+
+const extension = ".yaml"
+
+func path(dir, id string) string {
+	return filepath.Join(dir, id+extension)
+}
+
+// Create saves the journal of a new run in dir, fails instead of overwriting the journal of another run with the same id.
+func Create(dir string, journal *github.Journal) error {
+	return write(dir, journal, os.O_EXCL)
+}
+
+// Save the journal of a run in dir, removes the journal of the run when nothing is left to roll back.
+func Save(dir string, journal *github.Journal) error {
+	if len(journal.Entries) == 0 {
+		return Remove(dir, journal.ID)
+	}
+
+	return write(dir, journal, os.O_TRUNC)
+}
+
+func write(dir string, journal *github.Journal, flag int) error {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return fmt.Errorf("failed to create %s: %v", dir, err)
+	}
+
+	content, err := yaml.Marshal(journal)
+	if err != nil {
+		return fmt.Errorf("failed to serialize run %s: %v", journal.ID, err)
+	}
+
+	f, err := os.OpenFile(path(dir, journal.ID), os.O_WRONLY|os.O_CREATE|flag, 0600)
+	if errors.Is(err, os.ErrExist) {
+		return fmt.Errorf("failed to write run %s: a run with the same id exists", journal.ID)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to write run %s: %v", journal.ID, err)
+	}
+
+	if _, err := f.Write(content); err != nil {
+		f.Close()
+		return fmt.Errorf("failed to write run %s: %v", journal.ID, err)
+	}
+
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("failed to write run %s: %v", journal.ID, err)
+	}
+
+	return nil
+}
+
+// Read the journal of run id in dir.
+func Read(dir, id string) (*github.Journal, error) {
+	content, err := ioutil.ReadFile(path(dir, id))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("run %s doesn't exist or was already rolled back", id)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to read run %s: %v", id, err)
+	}
+
+	var journal github.Journal
+	if err := yaml.Unmarshal(content, &journal); err != nil {
+		return nil, fmt.Errorf("failed to parse run %s: %v", id, err)
+	}
+
+	return &journal, nil
+}
+
+// Remove the journal of run id in dir.
+func Remove(dir, id string) error {
+	if err := os.Remove(path(dir, id)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to remove run %s: %v", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package runs_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NickHackman/releaser/internal/github"
+	"github.com/NickHackman/releaser/internal/runs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSaveRead(t *testing.T) {
+	dir := t.TempDir()
+
+	journal := &github.Journal{
+		ID:      "20220131-120000.000",
+		Host:    "github.com",
+		Created: time.Date(2022, time.January, 31, 12, 0, 0, 0, time.UTC),
+		Entries: []*github.Entry{
+			{Kind: github.CommitEntry, Owner: "example", Repo: "example1", Ref: "main", SHA: "def456", Parent: "abc123"},
+			{Kind: github.TagEntry, Owner: "example", Repo: "example1", Ref: "v1.3.0"},
+			{Kind: github.ReleaseEntry, Owner: "example", Repo: "example1", ID: 42},
+		},
+	}
+
+	require.NoError(t, runs.Create(dir, journal))
+
+	read, err := runs.Read(dir, journal.ID)
+	require.NoError(t, err)
+	assert.Equal(t, journal.Entries, read.Entries)
+	assert.Equal(t, journal.Created, read.Created)
+
+	// Nothing left to roll back
+	journal.Entries = nil
+	require.NoError(t, runs.Save(dir, journal))
+
+	_, err = runs.Read(dir, journal.ID)
+	assert.Error(t, err)
+}
+
+func TestCreateExisting(t *testing.T) {
+	dir := t.TempDir()
+
+	journal := &github.Journal{
+		ID:      "20220131-120000.000",
+		Host:    "github.com",
+		Entries: []*github.Entry{{Kind: github.ReleaseEntry, Owner: "example", Repo: "example1", ID: 42}},
+	}
+
+	require.NoError(t, runs.Create(dir, journal))
+
+	other := &github.Journal{
+		ID:      journal.ID,
+		Host:    "github.com",
+		Entries: []*github.Entry{{Kind: github.ReleaseEntry, Owner: "example", Repo: "example2", ID: 7}},
+	}
+
+	assert.Error(t, runs.Create(dir, other))
+
+	read, err := runs.Read(dir, journal.ID)
+	require.NoError(t, err)
+	assert.Equal(t, journal.Entries, read.Entries)
+
+	// Rolling back part of a run saves over it
+	journal.Entries[0].ID = 43
+	require.NoError(t, runs.Save(dir, journal))
+
+	read, err = runs.Read(dir, journal.ID)
+	require.NoError(t, err)
+	assert.Equal(t, journal.Entries, read.Entries)
+}
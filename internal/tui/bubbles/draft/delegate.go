@@ -0,0 +1,79 @@
+package draft
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/muesli/reflow/truncate"
+)
+
+const (
+	terminalWidth = 70
+	dateFormat    = "2006-01-02 15:04"
+)
+
+type Delegate struct {
+	Keys *keyMap
+}
+
+func NewDelegate() Delegate {
+	return Delegate{Keys: newKeyMap()}
+}
+
+func (d Delegate) Height() int {
+	return 3
+}
+
+func (d Delegate) Spacing() int {
+	return 2
+}
+
+func (d Delegate) Update(msg tea.Msg, m *list.Model) tea.Cmd {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, d.Keys.Selection):
+			current, ok := m.SelectedItem().(Item)
+			if !ok {
+				break
+			}
+
+			return m.SetItem(m.Index(), current.Select())
+		}
+	}
+
+	return nil
+}
+
+func (d Delegate) Render(w io.Writer, m list.Model, index int, listItem list.Item) {
+	item, ok := listItem.(Item)
+	if !ok {
+		return
+	}
+
+	var output strings.Builder
+	output.WriteString(titleStyle.Render(item.Repo) + " " + versionStyle.Render(item.Release.GetTagName()))
+
+	if item.Selected {
+		output.WriteString(checkmarkStyle.Render(" ✓"))
+	}
+
+	created := fmt.Sprintf("drafted by %s on %s", item.Release.GetAuthor().GetLogin(), item.Release.GetCreatedAt().Format(dateFormat))
+	output.WriteString("\n" + descriptionStyle.Render(truncate.StringWithTail(created, terminalWidth, "...")))
+
+	if url := item.Release.GetHTMLURL(); url != "" {
+		text := truncate.StringWithTail(url, terminalWidth, "...")
+		output.WriteString("\n" + urlStyle.Render(text))
+	}
+
+	render := unselectedStyle.MaxWidth(terminalWidth).Render
+	if index == m.Index() {
+		render = selectedStyle.MaxWidth(terminalWidth).Render
+	}
+
+	fmt.Fprint(w, render(output.String()))
+}
@@ -0,0 +1,19 @@
+package draft
+
+import "github.com/NickHackman/releaser/internal/github"
+
+type Item struct {
+	*github.DraftRelease
+	Selected bool
+}
+
+func (i Item) FilterValue() string {
+	return i.Repo + " " + i.Release.GetTagName()
+}
+
+func (i Item) Select() Item {
+	return Item{
+		DraftRelease: i.DraftRelease,
+		Selected:     !i.Selected,
+	}
+}
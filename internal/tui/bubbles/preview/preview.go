@@ -4,6 +4,7 @@ import (
 	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/reflow/truncate"
 )
 
 const (
@@ -19,6 +20,7 @@ type Model struct {
 	viewport viewport.Model
 	branch   string
 	version  string
+	change   string
 	Width    int
 }
 
@@ -31,16 +33,18 @@ func New() Model {
 	}
 }
 
-func (m *Model) SetContent(content, branch, version string) {
+func (m *Model) SetContent(content, branch, version, change string) {
 	m.viewport.SetContent(content)
 	m.branch = branch
 	m.version = version
+	m.change = change
 }
 
 func (m *Model) SetLoading() {
 	m.viewport.SetContent(loadingMessage)
 	m.branch = ""
 	m.version = ""
+	m.change = ""
 }
 
 func (m *Model) SetSize(width, height int) {
@@ -75,11 +79,15 @@ func (m Model) statusBarView() string {
 	version := statusStyle.Render(m.version)
 	branch := statusStyle.Render(branchIcon + " " + m.branch)
 
+	// Change is truncated to fit between the version and branch
+	changeWidth := m.Width - lipgloss.Width(version) - lipgloss.Width(branch)
+	change := changeStyle.MaxWidth(changeWidth).Render(truncate.StringWithTail(m.change, uint(max(changeWidth-2, 0)), "..."))
+
 	sep := lipgloss.NewStyle().
-		Width(m.Width - lipgloss.Width(version) - lipgloss.Width(branch)).
+		Width(changeWidth - lipgloss.Width(change)).
 		Render("")
 
-	bar := lipgloss.JoinHorizontal(lipgloss.Left, version, sep, branch)
+	bar := lipgloss.JoinHorizontal(lipgloss.Left, version, change, sep, branch)
 
 	return statusBarStyle.Render(bar)
 }
@@ -90,3 +98,11 @@ func (m Model) View() string {
 
 	return lipgloss.JoinVertical(lipgloss.Left, body, m.statusBarView())
 }
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+
+	return b
+}
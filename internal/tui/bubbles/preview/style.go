@@ -8,6 +8,7 @@ import (
 var (
 	titleStyle     = lipgloss.NewStyle().Padding(1).MarginLeft(1).Background(colors.Title).Bold(true)
 	statusStyle    = lipgloss.NewStyle().Background(colors.Selected).Padding(0, 1).Bold(true)
+	changeStyle    = lipgloss.NewStyle().Faint(true).Padding(0, 1)
 	statusBarStyle = lipgloss.NewStyle().MaxHeight(2).MarginTop(1)
 	contentStyle   = lipgloss.NewStyle().MarginTop(1)
 	style          = lipgloss.NewStyle().Border(lipgloss.RoundedBorder(), false, false, false, true).PaddingLeft(1).BorderForeground(colors.Selected)
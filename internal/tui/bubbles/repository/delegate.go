@@ -65,12 +65,36 @@ func (d Delegate) Render(w io.Writer, m list.Model, index int, listItem list.Ite
 	}
 
 	var output strings.Builder
+
+	// Repositories of several owners may share names
+	if len(d.config.Orgs) > 1 {
+		output.WriteString(ownerStyle.Render(item.Repo.GetOwner().GetLogin() + "/"))
+	}
+
 	output.WriteString(titleStyle.Render(item.Repo.GetName()))
 
+	switch item.CI {
+	case github.CISuccess:
+		output.WriteString(ciSuccessStyle.Render(" ●"))
+	case github.CIPending:
+		output.WriteString(ciPendingStyle.Render(" ●"))
+	case github.CIFailure:
+		output.WriteString(ciFailureStyle.Render(" ✗"))
+	}
+
 	if item.Selected {
 		output.WriteString(checkmarkStyle.Render(" ✓"))
 	}
 
+	output.WriteString(statsStyle.Render(fmt.Sprintf(" %d commits", item.AheadBy)))
+	if len(item.Noise) > 0 {
+		output.WriteString(statsStyle.Render(fmt.Sprintf(" (%d noise)", len(item.Noise))))
+	}
+	if item.FilesChanged > 0 {
+		output.WriteString(statsStyle.Render(fmt.Sprintf(", %d files ", item.FilesChanged)))
+		output.WriteString(additionsStyle.Render(fmt.Sprintf("+%d", item.Additions)) + " " + deletionsStyle.Render(fmt.Sprintf("-%d", item.Deletions)))
+	}
+
 	if description := item.Repo.GetDescription(); description != "" {
 		text := truncate.StringWithTail(description, terminalWidth, "...")
 		output.WriteString("\n" + descriptionStyle.Render(text))
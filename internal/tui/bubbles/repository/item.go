@@ -2,6 +2,7 @@ package repository
 
 import (
 	"github.com/NickHackman/releaser/internal/github"
+	"github.com/NickHackman/releaser/internal/version"
 )
 
 type Item struct {
@@ -10,18 +11,72 @@ type Item struct {
 	Preview                         string
 	Branch                          string
 	Version                         string
+	Change                          string
+	// Override change for this repository instead of the configured change, empty when not overridden.
+	Override version.Change `yaml:"-"`
+	// GeneratedNotes release notes generated by GitHub for Version, empty when unused.
+	GeneratedNotes string `yaml:"-"`
+	// NotesError failure generating GeneratedNotes, items with one can't be selected.
+	NotesError error `yaml:"-"`
+	// CI state of the commit to release, empty when not checked.
+	CI github.CIState `yaml:"-"`
 }
 
 func (i Item) FilterValue() string {
-	return i.Repo.GetName()
+	return i.Repo.GetOwner().GetLogin() + "/" + i.Repo.GetName()
 }
 
+// Select toggles the selection of the item, items without a version or with a NotesError can't be selected.
 func (i Item) Select() Item {
 	return Item{
 		ReleaseableRepoResponse: i.ReleaseableRepoResponse,
 		Preview:                 i.Preview,
+		GeneratedNotes:          i.GeneratedNotes,
+		NotesError:              i.NotesError,
 		Branch:                  i.Branch,
-		Selected:                !i.Selected,
+		Selected:                !i.Selected && i.releasable(),
 		Version:                 i.Version,
+		Change:                  i.Change,
+		Override:                i.Override,
+		CI:                      i.CI,
 	}
 }
+
+// SetVersion the version of the item and a description of the change, items without a version can't be selected.
+//
+// NotesError is kept until the notes of version are generated.
+func (i Item) SetVersion(version, change string, override version.Change) Item {
+	return Item{
+		ReleaseableRepoResponse: i.ReleaseableRepoResponse,
+		Preview:                 i.Preview,
+		GeneratedNotes:          i.GeneratedNotes,
+		NotesError:              i.NotesError,
+		Branch:                  i.Branch,
+		Selected:                i.Selected && version != "" && i.NotesError == nil,
+		Version:                 version,
+		Change:                  change,
+		Override:                override,
+		CI:                      i.CI,
+	}
+}
+
+// SetNotes the release notes generated by GitHub and the preview that uses them, err is the failure generating them.
+func (i Item) SetNotes(notes, preview string, err error) Item {
+	return Item{
+		ReleaseableRepoResponse: i.ReleaseableRepoResponse,
+		Preview:                 preview,
+		GeneratedNotes:          notes,
+		NotesError:              err,
+		Branch:                  i.Branch,
+		Selected:                i.Selected && err == nil,
+		Version:                 i.Version,
+		Change:                  i.Change,
+		Override:                i.Override,
+		CI:                      i.CI,
+	}
+}
+
+// releasable whether the item has a version and its notes, if any, were generated.
+func (i Item) releasable() bool {
+	return i.Version != "" && i.NotesError == nil
+}
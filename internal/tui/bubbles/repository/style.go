@@ -7,9 +7,16 @@ import (
 
 var (
 	titleStyle       = lipgloss.NewStyle().Bold(true)
+	ownerStyle       = lipgloss.NewStyle().Faint(true)
 	descriptionStyle = lipgloss.NewStyle().Faint(true).MaxWidth(75)
 	urlStyle         = lipgloss.NewStyle().Underline(true).Foreground(colors.URL)
 	selectedStyle    = lipgloss.NewStyle().Border(lipgloss.NormalBorder(), false, false, false, true).BorderForeground(colors.Selected)
 	unselectedStyle  = lipgloss.NewStyle().PaddingLeft(1)
 	checkmarkStyle   = lipgloss.NewStyle().Foreground(colors.Title).Bold(true)
+	statsStyle       = lipgloss.NewStyle().Faint(true)
+	additionsStyle   = lipgloss.NewStyle().Foreground(colors.Additions)
+	deletionsStyle   = lipgloss.NewStyle().Foreground(colors.Deletions)
+	ciSuccessStyle   = lipgloss.NewStyle().Foreground(colors.Additions)
+	ciPendingStyle   = lipgloss.NewStyle().Foreground(colors.Pending)
+	ciFailureStyle   = lipgloss.NewStyle().Foreground(colors.Deletions).Bold(true)
 )
@@ -0,0 +1,12 @@
+package team
+
+type Item struct {
+	// Slug of the team, empty for every repository of the organization.
+	Slug        string
+	Name        string
+	Description string
+}
+
+func (i Item) FilterValue() string {
+	return i.Name
+}
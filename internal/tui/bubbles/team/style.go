@@ -0,0 +1,13 @@
+package team
+
+import (
+	"github.com/NickHackman/releaser/internal/tui/colors"
+	"github.com/charmbracelet/lipgloss"
+)
+
+var (
+	titleStyle       = lipgloss.NewStyle().Bold(true)
+	descriptionStyle = lipgloss.NewStyle().Faint(true)
+	selectedStyle    = lipgloss.NewStyle().Border(lipgloss.NormalBorder(), false, false, false, true).BorderForeground(colors.Selected)
+	unselectedStyle  = lipgloss.NewStyle().PaddingLeft(1)
+)
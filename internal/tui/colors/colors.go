@@ -8,4 +8,7 @@ var (
 	URL           = lipgloss.Color("#7c5295")
 	ProgressStart = "#967bb6"
 	ProgressEnd   = "#bf94e4"
+	Additions     = lipgloss.Color("#2ea043")
+	Deletions     = lipgloss.Color("#f85149")
+	Pending       = lipgloss.Color("#d29922")
 )
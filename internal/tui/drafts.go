@@ -0,0 +1,79 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NickHackman/releaser/internal/config"
+	"github.com/NickHackman/releaser/internal/github"
+	"github.com/NickHackman/releaser/internal/tui/pages/drafts"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// PublishDrafts publishes draft releases of the organization, or the authenticated user when there is no organization.
+// Publishes the drafts of config.Repositories without interactive UI.
+func PublishDrafts(gh *github.Client, config *config.Config) error {
+	if config.Org == "" {
+		config.Org = config.Username
+	}
+
+	if len(config.Repositories) != 0 {
+		return noninteractivePublishDrafts(gh, config)
+	}
+
+	releasesChan := make(chan []*github.RepositoryReleaseResponse, 1)
+	config.Terminal.Releases = releasesChan
+
+	if err := tea.NewProgram(drafts.New(gh, config), tea.WithAltScreen(), tea.WithMouseAllMotion()).Start(); err != nil {
+		return fmt.Errorf("failed to execute tui: %v", err)
+	}
+
+	select {
+	case releases := <-releasesChan:
+		if len(releases) > 0 {
+			printReleases(releases)
+		}
+	default:
+		fmt.Println("No drafts were published.")
+	}
+
+	return nil
+}
+
+func noninteractivePublishDrafts(gh *github.Client, config *config.Config) error {
+	ctx, cancel := context.WithTimeout(context.Background(), config.Timeout)
+	defer cancel()
+
+	all, err := gh.DraftReleases(ctx, config.Org, config.Org == config.Username)
+	if err != nil {
+		return err
+	}
+
+	var toPublish []*github.DraftRelease
+	for _, draft := range all {
+		if config.IsRepositoryToRelease(draft.Owner, draft.Repo) {
+			toPublish = append(toPublish, draft)
+		}
+	}
+
+	if config.DryRun {
+		fmt.Printf("Dry run: %d draft(s) would be published.\n\n", len(toPublish))
+
+		for _, draft := range toPublish {
+			fullName := titleStyle.Render("## " + draft.Owner + "/" + draft.Repo)
+			fmt.Printf("%s %s\n%s\n\n", fullName, versionStyle.Render(draft.Release.GetTagName()), urlStyle.Render(draft.Release.GetHTMLURL()))
+		}
+
+		return nil
+	}
+
+	response := gh.PublishDrafts(ctx, toPublish)
+
+	if len(response) > 0 {
+		printReleases(response)
+	} else {
+		fmt.Println("No drafts were published.")
+	}
+
+	return nil
+}
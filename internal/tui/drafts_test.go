@@ -0,0 +1,37 @@
+package tui
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/NickHackman/releaser/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPublishDraftsDryRun(t *testing.T) {
+	gh, host := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v3/orgs/example/repos":
+			_, _ = w.Write([]byte(`[{"name": "example1"}, {"name": "example2"}]`))
+		case "/api/v3/repos/example/example1/releases":
+			_, _ = w.Write([]byte(`[{"id": 1, "tag_name": "v1.1.0", "draft": true, "html_url": "https://github.com/example/example1/releases/v1.1.0"}]`))
+		case "/api/v3/repos/example/example2/releases":
+			_, _ = w.Write([]byte(`[{"id": 2, "tag_name": "v2.0.0", "draft": true}]`))
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+	})
+
+	c := &config.Config{Host: host, Org: "example", Username: "octocat", Repositories: []string{"example1"}, DryRun: true, Timeout: time.Minute}
+
+	var err error
+	output := captureStdout(t, func() { err = PublishDrafts(gh, c) })
+	require.NoError(t, err)
+
+	assert.Contains(t, output, "Dry run: 1 draft(s) would be published.")
+	assert.Contains(t, output, "## example/example1 v1.1.0")
+	assert.Contains(t, output, "https://github.com/example/example1/releases/v1.1.0")
+	assert.NotContains(t, output, "example2")
+}
@@ -0,0 +1,77 @@
+package drafts
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/NickHackman/releaser/internal/github"
+	"github.com/NickHackman/releaser/internal/tui/bubbles/draft"
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/cli/browser"
+)
+
+type errorCmd error
+
+type loadedDraftsCmd []list.Item
+
+func (m *Model) loadDraftsCmd() tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), m.config.Timeout)
+		defer cancel()
+
+		drafts, err := m.gh.DraftReleases(ctx, m.config.Org, m.config.Org == m.config.Username)
+		if err != nil {
+			return errorCmd(err)
+		}
+
+		items := make([]list.Item, 0, len(drafts))
+		for _, d := range drafts {
+			items = append(items, draft.Item{DraftRelease: d})
+		}
+
+		return loadedDraftsCmd(items)
+	}
+}
+
+func (m *Model) publishCmd() tea.Cmd {
+	return func() tea.Msg {
+		var drafts []*github.DraftRelease
+		for _, item := range m.list.Items() {
+			i, ok := item.(draft.Item)
+			if !ok || !i.Selected {
+				continue
+			}
+
+			drafts = append(drafts, i.DraftRelease)
+		}
+
+		if len(drafts) == 0 {
+			return nil
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), m.config.Timeout)
+		defer cancel()
+
+		m.config.Terminal.Releases <- m.gh.PublishDrafts(ctx, drafts)
+		return tea.Quit()
+	}
+}
+
+func (m *Model) openURLCmd() tea.Cmd {
+	return func() tea.Msg {
+		item, ok := m.list.SelectedItem().(draft.Item)
+		if !ok {
+			return nil
+		}
+
+		var output bytes.Buffer
+		browser.Stdout = &output
+
+		if err := browser.OpenURL(item.Release.GetHTMLURL()); err != nil {
+			return errorCmd(err)
+		}
+
+		return nil
+	}
+}
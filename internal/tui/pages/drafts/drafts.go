@@ -0,0 +1,189 @@
+package drafts
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/NickHackman/releaser/internal/config"
+	"github.com/NickHackman/releaser/internal/github"
+	"github.com/NickHackman/releaser/internal/tui/bubbles/draft"
+	"github.com/NickHackman/releaser/internal/tui/bubbles/preview"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const (
+	minTerminalWidth         = 150
+	listWidth                = 75
+	increaseTerminalWidthMsg = "Increase width of terminal to display content."
+	noDraftsMsg              = "No draft releases."
+	draftChange              = "draft"
+)
+
+// Model page to select draft releases of an organization to publish.
+type Model struct {
+	list    list.Model
+	preview preview.Model
+	keys    *keyMap
+
+	gh     *github.Client
+	config *config.Config
+}
+
+func New(gh *github.Client, config *config.Config) *Model {
+	keys := newKeyMap()
+	delegate := draft.NewDelegate()
+
+	list := list.NewModel([]list.Item{}, delegate, 0, 0)
+	list.Title = fmt.Sprintf("%s Draft Releases", strings.Title(config.Org))
+	list.SetShowHelp(false)
+	list.Styles.Title = listTitleStyle
+
+	list.AdditionalFullHelpKeys = func() []key.Binding {
+		return []key.Binding{
+			delegate.Keys.Selection,
+			keys.Open,
+			keys.Publish,
+			keys.Refresh,
+			keys.ToggleAll,
+		}
+	}
+
+	list.AdditionalShortHelpKeys = func() []key.Binding {
+		return []key.Binding{
+			delegate.Keys.Selection,
+			keys.Refresh,
+			keys.Publish,
+		}
+	}
+
+	m := &Model{
+		list:    list,
+		preview: preview.New(),
+		keys:    keys,
+		gh:      gh,
+		config:  config,
+	}
+
+	m.SetSize(config.Size())
+	return m
+}
+
+func (m Model) Init() tea.Cmd {
+	return m.loadDraftsCmd()
+}
+
+func (m *Model) SetSize(width, height int) {
+	m.config.SetSize(width, height)
+
+	statusHeight := lipgloss.Height(m.statusView())
+
+	m.list.SetSize(width, height-statusHeight-1)
+	m.list.Help.Width = width
+
+	m.preview.SetSize(width-listWidth, height-statusHeight-1)
+}
+
+func (m Model) countSelected() int {
+	var selected int
+
+	for _, item := range m.list.Items() {
+		if current, ok := item.(draft.Item); ok && current.Selected {
+			selected++
+		}
+	}
+
+	return selected
+}
+
+func (m *Model) refreshPreview() {
+	current, ok := m.list.SelectedItem().(draft.Item)
+	if !ok {
+		return
+	}
+
+	m.preview.SetContent(current.Release.GetBody(), current.Release.GetTargetCommitish(), current.Release.GetTagName(), draftChange)
+}
+
+func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmds []tea.Cmd
+	var cmd tea.Cmd
+
+	currentIndex := m.list.Index()
+
+	m.list, cmd = m.list.Update(msg)
+	cmds = append(cmds, cmd)
+
+	if currentIndex != m.list.Index() {
+		m.refreshPreview()
+	}
+
+	var previewModel tea.Model
+	previewModel, cmd = m.preview.Update(msg)
+	m.preview = previewModel.(preview.Model)
+	cmds = append(cmds, cmd)
+
+	switch msg := msg.(type) {
+	case errorCmd:
+		cmds = append(cmds, m.list.NewStatusMessage(msg.Error()))
+	case tea.WindowSizeMsg:
+		m.SetSize(msg.Width, msg.Height)
+	case loadedDraftsCmd:
+		cmds = append(cmds, m.list.SetItems(msg))
+
+		if len(msg) == 0 {
+			cmds = append(cmds, m.list.NewStatusMessage(noDraftsMsg))
+		}
+
+		m.refreshPreview()
+	case tea.KeyMsg:
+		if m.list.FilterState() == list.Filtering {
+			break
+		}
+
+		switch {
+		case key.Matches(msg, m.keys.More):
+			// Force reset size
+			m.SetSize(m.config.Size())
+		case key.Matches(msg, m.keys.Open):
+			cmds = append(cmds, m.openURLCmd())
+		case key.Matches(msg, m.keys.Publish):
+			cmds = append(cmds, m.publishCmd())
+		case key.Matches(msg, m.keys.Refresh):
+			m.preview.SetLoading()
+			cmds = append(cmds, m.list.SetItems([]list.Item{}), m.Init())
+		case key.Matches(msg, m.keys.ToggleAll):
+			var newItems []list.Item
+			for _, item := range m.list.Items() {
+				current, ok := item.(draft.Item)
+				if !ok {
+					continue
+				}
+
+				newItems = append(newItems, current.Select())
+			}
+
+			cmds = append(cmds, m.list.SetItems(newItems))
+		}
+	}
+
+	m.keys.Publish.SetEnabled(m.countSelected() > 0)
+
+	return m, tea.Batch(cmds...)
+}
+
+func (m Model) statusView() string {
+	return m.list.Styles.HelpStyle.Render(m.list.Help.View(m.list))
+}
+
+func (m Model) View() string {
+	w, _ := m.config.Size()
+	if w < minTerminalWidth {
+		return increaseTerminalWidthMsg
+	}
+
+	top := lipgloss.JoinHorizontal(lipgloss.Left, listStyle.Render(m.list.View()), m.preview.View())
+	return lipgloss.JoinVertical(lipgloss.Left, top, m.statusView())
+}
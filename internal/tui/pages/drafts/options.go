@@ -0,0 +1,39 @@
+package drafts
+
+import "github.com/charmbracelet/bubbles/key"
+
+type keyMap struct {
+	Publish   key.Binding
+	Open      key.Binding
+	Refresh   key.Binding
+	ToggleAll key.Binding
+	More      key.Binding
+}
+
+func newKeyMap() *keyMap {
+	keys := &keyMap{
+		Publish: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "publish draft(s)"),
+		),
+		ToggleAll: key.NewBinding(
+			key.WithKeys("a"),
+			key.WithHelp("a", "toggle all drafts"),
+		),
+		Open: key.NewBinding(
+			key.WithKeys("o"),
+			key.WithHelp("o", "open URL"),
+		),
+		Refresh: key.NewBinding(
+			key.WithKeys("r"),
+			key.WithHelp("r", "refresh"),
+		),
+		More: key.NewBinding(
+			key.WithKeys("?"),
+		),
+	}
+
+	keys.Publish.SetEnabled(false)
+
+	return keys
+}
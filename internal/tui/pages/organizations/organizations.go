@@ -8,6 +8,7 @@ import (
 	"github.com/NickHackman/releaser/internal/tui/bubbles/organization"
 	"github.com/NickHackman/releaser/internal/tui/colors"
 	"github.com/NickHackman/releaser/internal/tui/pages/repositories"
+	"github.com/NickHackman/releaser/internal/tui/pages/teams"
 	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/list"
 	"github.com/charmbracelet/bubbles/progress"
@@ -113,9 +114,16 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 
 			m.config.Org = organization.Login
+			m.config.Orgs = []string{organization.Login}
 
-			repositories := repositories.New(m.gh, m.config)
-			return repositories, repositories.Init()
+			// Users don't have teams
+			if organization.Login == m.config.Username {
+				repositories := repositories.New(m.gh, m.config)
+				return repositories, repositories.Init()
+			}
+
+			teams := teams.New(m.gh, m.config)
+			return teams, teams.Init()
 		case key.Matches(msg, m.keys.Refresh):
 			m.orgs = 0
 			m.channel = fetch(m.config, m.gh)
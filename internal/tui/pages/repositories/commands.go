@@ -19,6 +19,15 @@ import (
 
 type errorCmd error
 
+// notesMsg release notes generated by GitHub for version of repository owner/name.
+type notesMsg struct {
+	owner   string
+	name    string
+	version string
+	notes   string
+	err     error
+}
+
 func (m *Model) publishCmd() tea.Cmd {
 	return func() tea.Msg {
 		if m.countSelected() == 0 {
@@ -35,12 +44,19 @@ func (m *Model) publishCmd() tea.Cmd {
 				continue
 			}
 
-			sha := i.Commits[0].GetSHA()
+			releases = append(releases, NewRelease(i.ReleaseableRepoResponse, m.config, i.Version, i.Change, i.Preview))
+		}
 
-			releases = append(releases, &github.RepositoryRelease{Name: i.Repo.GetName(), Version: i.Version, Body: i.Preview, TargetSHA: sha})
+		if m.config.DryRun {
+			m.config.Terminal.Plan <- releases
+			return tea.Quit()
 		}
 
-		m.config.Terminal.Releases <- m.gh.CreateReleases(ctx, m.config.Org, releases, m.config.CreateReleaseBranch)
+		journal := github.NewJournal(m.config.Host)
+		options := github.CreateOptions{CreateReleaseBranch: m.config.CreateReleaseBranch, Atomic: m.config.Atomic, Journal: journal, CI: m.config.CI}
+
+		m.config.Terminal.Releases <- m.gh.CreateReleases(ctx, releases, options)
+		m.config.Terminal.Journal <- journal
 		return tea.Quit()
 	}
 }
@@ -63,22 +79,187 @@ func (m *Model) openURLCmd() tea.Cmd {
 	}
 }
 
-func loadRepositoriesCmd(channel <-chan *github.ReleaseableRepoResponse, config *config.Config) tea.Cmd {
+func loadRepositoriesCmd(channel <-chan *github.ReleaseableRepoResponse, gh *github.Client, config *config.Config) tea.Cmd {
 	return func() tea.Msg {
 		r, ok := <-channel
 		if !ok {
 			return nil
 		}
 
+		newVersion, change, err := NewVersion(r, config, config.VersionChange)
+		if err != nil {
+			// Releasable once a version is entered
+			return repository.Item{ReleaseableRepoResponse: r, Preview: fmt.Sprintf("Error: %v", err), Branch: r.Branch, Change: err.Error()}
+		}
+
+		notes, notesErr := GenerateNotes(gh, r, config, newVersion)
+
+		err = PullRequests(gh, r, config)
+
+		preview := Body(r, config, notes)
+		if err != nil {
+			preview += fmt.Sprintf("\n\nError: %v", err)
+		}
+
+		if notesErr != nil {
+			preview += fmt.Sprintf("\n\nError: %v", notesErr)
+		}
+
+		ci, err := CI(gh, r, config)
+		if err != nil {
+			preview += fmt.Sprintf("\n\nError: %v", err)
+		}
+
 		return repository.Item{
 			ReleaseableRepoResponse: r,
-			Preview:                 PreviewContent(r, config.Template),
+			Preview:                 preview,
+			GeneratedNotes:          notes,
+			NotesError:              notesErr,
 			Branch:                  r.Branch,
-			Version:                 version.New(r.LatestTag.GetName(), config.VersionChange),
+			Version:                 newVersion,
+			Change:                  change,
+			CI:                      ci,
+		}
+	}
+}
+
+// CI state of the commit of repository r to release, empty when CI isn't checked.
+func CI(gh *github.Client, r *github.ReleaseableRepoResponse, config *config.Config) (github.CIState, error) {
+	if config.CI == github.CIGateOff {
+		return github.CINone, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), config.Timeout)
+	defer cancel()
+
+	return gh.CI(ctx, r.Repo.GetOwner().GetLogin(), r.Repo.GetName(), r.Commits[0].GetSHA())
+}
+
+// generateNotesCmd regenerates the release notes of item after its version changed.
+func generateNotesCmd(gh *github.Client, config *config.Config, item repository.Item) tea.Cmd {
+	if !config.UsesGeneratedNotes() {
+		return nil
+	}
+
+	return func() tea.Msg {
+		notes, err := GenerateNotes(gh, item.ReleaseableRepoResponse, config, item.Version)
+
+		return notesMsg{
+			owner:   item.Repo.GetOwner().GetLogin(),
+			name:    item.Repo.GetName(),
+			version: item.Version,
+			notes:   notes,
+			err:     err,
 		}
 	}
 }
 
+// GenerateNotes release notes of newVersion generated by GitHub, empty when neither the template nor the body use them.
+func GenerateNotes(gh *github.Client, r *github.ReleaseableRepoResponse, config *config.Config, newVersion string) (string, error) {
+	if !config.UsesGeneratedNotes() {
+		return "", nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), config.Timeout)
+	defer cancel()
+
+	return gh.GenerateNotes(ctx, r.Repo.GetOwner().GetLogin(), r.Repo.GetName(), newVersion, r.LatestTag.GetName(), r.Commits[0].GetSHA())
+}
+
+// Body of the release of repository r, the generated notes when configured otherwise the template.
+func Body(r *github.ReleaseableRepoResponse, config *config.Config, generatedNotes string) string {
+	if config.GeneratedNotes {
+		return generatedNotes
+	}
+
+	return PreviewContent(r, config.Template, generatedNotes, config.Changelog)
+}
+
+// NewRelease the release of repository r with newVersion, the description of the change and body.
+func NewRelease(r *github.ReleaseableRepoResponse, config *config.Config, newVersion, change, body string) *github.RepositoryRelease {
+	name := r.Repo.GetName()
+
+	commits := make([]github.Commit, 0, len(r.Commits))
+	for _, c := range r.Commits {
+		commits = append(commits, github.Commit{SHA: c.GetSHA(), Summary: strings.Split(c.GetCommit().GetMessage(), "\n")[0]})
+	}
+
+	return &github.RepositoryRelease{
+		Owner:       r.Repo.GetOwner().GetLogin(),
+		Name:        name,
+		Version:     newVersion,
+		Change:      change,
+		Body:        body,
+		BaselineTag: r.LatestTag.GetName(),
+		TargetSHA:   r.Commits[0].GetSHA(),
+		Branch:      r.Branch,
+		Prerelease:  version.IsPreRelease(config.Scheme(name), config.TagFormat(name), newVersion),
+		Files:       config.VersionFiles(name),
+		Commits:     commits,
+		Draft:       config.Draft,
+		Assets:      config.AssetFiles(name),
+		Checksums:   config.Checksums,
+	}
+}
+
+// ValidateVersion checks that tag is a version of the repository's version scheme and tag format that is greater than
+// every existing tag.
+func ValidateVersion(r *github.ReleaseableRepoResponse, config *config.Config, tag string) error {
+	name := r.Repo.GetName()
+	scheme, format := config.Scheme(name), config.TagFormat(name)
+
+	if !version.ValidTag(scheme, format, tag) {
+		return fmt.Errorf("invalid version '%s' for %s", tag, name)
+	}
+
+	highest := r.HighestTag.GetName()
+	if result, ok := version.CompareTags(scheme, tag, highest); ok && result <= 0 {
+		return fmt.Errorf("version '%s' must be greater than existing %s", tag, highest)
+	}
+
+	return nil
+}
+
+// NewVersion determines the new tag for a repository using its version scheme and tag format and describes the change applied,
+// when the change is version.IncAuto the description includes why the change was inferred.
+//
+// The new tag is always greater than every existing tag, including those on other branches, otherwise an error is
+// returned.
+func NewVersion(r *github.ReleaseableRepoResponse, config *config.Config, change version.Change) (string, string, error) {
+	name := r.Repo.GetName()
+	scheme, format := config.Scheme(name), config.TagFormat(name)
+
+	description := string(change)
+	if change == version.IncAuto {
+		messages := make([]string, 0, len(r.Commits))
+		for _, c := range r.Commits {
+			// Noise doesn't determine the change
+			if !r.Noise[c.GetSHA()] {
+				messages = append(messages, c.GetCommit().GetMessage())
+			}
+		}
+
+		var reason string
+		change, reason = version.Infer(messages)
+		description = fmt.Sprintf("%s (%s)", change, reason)
+	}
+
+	newVersion := version.NextTag(scheme, format, r.LatestTag.GetName(), change)
+
+	highest := r.HighestTag.GetName()
+	if result, ok := version.CompareTags(scheme, newVersion, highest); ok && result <= 0 {
+		newVersion = version.NextTag(scheme, format, highest, change)
+		description += fmt.Sprintf(", after existing %s", highest)
+
+		// Schemes return versions they can't increment unchanged
+		if result, ok := version.CompareTags(scheme, newVersion, highest); ok && result <= 0 {
+			return "", "", fmt.Errorf("no %s version of %s greater than existing %s", change, name, highest)
+		}
+	}
+
+	return newVersion, description, nil
+}
+
 type commitTemplate struct {
 	Sha     string
 	URL     string
@@ -96,31 +277,184 @@ type commitTemplate struct {
 	CommitterEmail    string
 	CommitterDate     time.Time
 	CommitterURL      string
+
+	// PR merged pull request the commit belongs to, nil when there is none.
+	PR *pullRequestTemplate
+}
+
+type pullRequestTemplate struct {
+	Number   int
+	Title    string
+	URL      string
+	Body     string
+	Labels   []string
+	MergedAt time.Time
+
+	AuthorUsername string
+	AuthorURL      string
+}
+
+// newPullRequestTemplate the template of the pull request of commit sha, cached by number so commits of the same pull
+// request share it.
+func newPullRequestTemplate(r *github.ReleaseableRepoResponse, sha string, cache map[int]*pullRequestTemplate) *pullRequestTemplate {
+	pr, ok := r.PullRequests[sha]
+	if !ok {
+		return nil
+	}
+
+	if cached, ok := cache[pr.GetNumber()]; ok {
+		return cached
+	}
+
+	labels := make([]string, 0, len(pr.Labels))
+	for _, label := range pr.Labels {
+		labels = append(labels, label.GetName())
+	}
+
+	template := &pullRequestTemplate{
+		Number:         pr.GetNumber(),
+		Title:          pr.GetTitle(),
+		URL:            pr.GetHTMLURL(),
+		Body:           pr.GetBody(),
+		Labels:         labels,
+		MergedAt:       pr.GetMergedAt(),
+		AuthorUsername: pr.GetUser().GetLogin(),
+		AuthorURL:      pr.GetUser().GetHTMLURL(),
+	}
+
+	cache[pr.GetNumber()] = template
+	return template
+}
+
+type sectionTemplate struct {
+	Title   string
+	Entries []*entryTemplate
 }
 
-func PreviewContent(r *github.ReleaseableRepoResponse, templatedString string) string {
-	var templateCommits []*commitTemplate
+// entryTemplate a pull request in a section, or a commit without a pull request where Number is 0.
+type entryTemplate struct {
+	Title          string
+	URL            string
+	Number         int
+	Labels         []string
+	AuthorUsername string
+}
+
+// newSections groups the pull requests of commits, and commits without one, into the sections of changelog by their
+// labels. Sections are in the order of changelog with its other section last, followed by the noise section of noise
+// commits when configured. Empty sections are omitted.
+func newSections(commits, noise []*commitTemplate, changelog config.Changelog) []*sectionTemplate {
+	sections := make(map[string]*sectionTemplate)
+	seen := make(map[*pullRequestTemplate]bool)
+
+	for _, c := range commits {
+		entry := newEntry(c, seen)
+		if entry == nil {
+			continue
+		}
+
+		title, ok := changelog.Section(entry.Labels)
+		if !ok {
+			continue
+		}
+
+		if _, ok := sections[title]; !ok {
+			sections[title] = &sectionTemplate{Title: title}
+		}
+
+		sections[title].Entries = append(sections[title].Entries, entry)
+	}
+
+	titles := make([]string, 0, len(changelog.Sections)+1)
+	for _, section := range changelog.Sections {
+		titles = append(titles, section.Title)
+	}
+	titles = append(titles, changelog.Other)
+
+	var ordered []*sectionTemplate
+	for _, title := range titles {
+		section, ok := sections[title]
+		if !ok {
+			continue
+		}
+
+		ordered = append(ordered, section)
+		// Sections sharing a title are rendered once
+		delete(sections, title)
+	}
+
+	if changelog.Noise == "" {
+		return ordered
+	}
+
+	noiseSection := &sectionTemplate{Title: changelog.Noise}
+	for _, c := range noise {
+		// Pull requests with changes that aren't noise are in their section
+		if entry := newEntry(c, seen); entry != nil {
+			noiseSection.Entries = append(noiseSection.Entries, entry)
+		}
+	}
 
+	if len(noiseSection.Entries) > 0 {
+		ordered = append(ordered, noiseSection)
+	}
+
+	return ordered
+}
+
+// newEntry the entry of the pull request of c, or of c without one, nil when the pull request already has an entry.
+func newEntry(c *commitTemplate, seen map[*pullRequestTemplate]bool) *entryTemplate {
+	if c.PR == nil {
+		return &entryTemplate{Title: c.Summary, URL: c.URL, AuthorUsername: c.AuthorUsername}
+	}
+
+	if seen[c.PR] {
+		return nil
+	}
+
+	seen[c.PR] = true
+	return &entryTemplate{Title: c.PR.Title, URL: c.PR.URL, Number: c.PR.Number, Labels: c.PR.Labels, AuthorUsername: c.PR.AuthorUsername}
+}
+
+func PreviewContent(r *github.ReleaseableRepoResponse, templatedString, generatedNotes string, changelog config.Changelog) string {
+	var templateCommits, noiseCommits []*commitTemplate
+	var templatePullRequests []*pullRequestTemplate
+
+	pullRequests := make(map[int]*pullRequestTemplate)
 	for _, c := range r.Commits {
-		templateCommits = append(templateCommits,
-			&commitTemplate{
-				Sha:     c.GetSHA(),
-				URL:     c.GetHTMLURL(),
-				Message: c.GetCommit().GetMessage(),
-				Summary: strings.Split(c.GetCommit().GetMessage(), "\n")[0],
-
-				AuthorUsername: c.GetAuthor().GetLogin(),
-				AuthorURL:      c.GetAuthor().GetURL(),
-				AuthorName:     c.GetCommit().GetAuthor().GetName(),
-				AuthorEmail:    c.GetCommit().GetAuthor().GetEmail(),
-				AuthorDate:     c.GetCommit().GetAuthor().GetDate(),
-
-				CommitterUsername: c.GetCommitter().GetLogin(),
-				CommitterURL:      c.GetCommitter().GetURL(),
-				CommitterName:     c.GetCommit().GetCommitter().GetName(),
-				CommitterEmail:    c.GetCommit().GetCommitter().GetEmail(),
-				CommitterDate:     c.GetCommit().GetCommitter().GetDate(),
-			})
+		commit := &commitTemplate{
+			Sha:     c.GetSHA(),
+			URL:     c.GetHTMLURL(),
+			Message: c.GetCommit().GetMessage(),
+			Summary: strings.Split(c.GetCommit().GetMessage(), "\n")[0],
+
+			AuthorUsername: c.GetAuthor().GetLogin(),
+			AuthorURL:      c.GetAuthor().GetURL(),
+			AuthorName:     c.GetCommit().GetAuthor().GetName(),
+			AuthorEmail:    c.GetCommit().GetAuthor().GetEmail(),
+			AuthorDate:     c.GetCommit().GetAuthor().GetDate(),
+
+			CommitterUsername: c.GetCommitter().GetLogin(),
+			CommitterURL:      c.GetCommitter().GetURL(),
+			CommitterName:     c.GetCommit().GetCommitter().GetName(),
+			CommitterEmail:    c.GetCommit().GetCommitter().GetEmail(),
+			CommitterDate:     c.GetCommit().GetCommitter().GetDate(),
+
+			PR: newPullRequestTemplate(r, c.GetSHA(), pullRequests),
+		}
+
+		// Noise is only in its own section
+		if r.Noise[c.GetSHA()] {
+			noiseCommits = append(noiseCommits, commit)
+			continue
+		}
+
+		// Pull requests in the order of their newest commit
+		if commit.PR != nil && !containsPullRequest(templatePullRequests, commit.PR) {
+			templatePullRequests = append(templatePullRequests, commit.PR)
+		}
+
+		templateCommits = append(templateCommits, commit)
 	}
 
 	params := map[string]interface{}{
@@ -130,6 +464,14 @@ func PreviewContent(r *github.ReleaseableRepoResponse, templatedString string) s
 		"RepositoryDescription":   r.Repo.GetDescription(),
 		"RepositoryDefaultBranch": r.Repo.GetDefaultBranch(),
 		"Commits":                 templateCommits,
+		"PullRequests":            templatePullRequests,
+		"Sections":                newSections(templateCommits, noiseCommits, changelog),
+		"Noise":                   noiseCommits,
+		"AheadBy":                 r.AheadBy,
+		"FilesChanged":            r.FilesChanged,
+		"Additions":               r.Additions,
+		"Deletions":               r.Deletions,
+		"GeneratedNotes":          generatedNotes,
 	}
 
 	sf := sprig.TxtFuncMap()
@@ -146,3 +488,31 @@ func PreviewContent(r *github.ReleaseableRepoResponse, templatedString string) s
 
 	return buf.String()
 }
+
+func containsPullRequest(pullRequests []*pullRequestTemplate, pr *pullRequestTemplate) bool {
+	for _, current := range pullRequests {
+		if current == pr {
+			return true
+		}
+	}
+
+	return false
+}
+
+// PullRequests resolves the merged pull requests of the commits of r when the template uses them.
+func PullRequests(gh *github.Client, r *github.ReleaseableRepoResponse, config *config.Config) error {
+	if !config.UsesPullRequests() || r.PullRequests != nil {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), config.Timeout)
+	defer cancel()
+
+	pullRequests, err := gh.PullRequests(ctx, r.Repo.GetOwner().GetLogin(), r.Repo.GetName(), r.Commits)
+	if err != nil {
+		return err
+	}
+
+	r.PullRequests = pullRequests
+	return nil
+}
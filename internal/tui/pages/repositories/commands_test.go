@@ -0,0 +1,86 @@
+package repositories
+
+import (
+	"testing"
+
+	"github.com/NickHackman/releaser/internal/config"
+	"github.com/NickHackman/releaser/internal/github"
+	"github.com/NickHackman/releaser/internal/version"
+	gogithub "github.com/google/go-github/v41/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// releaseableRepo a repository example1 with latest and highest tags, empty for no tag.
+func releaseableRepo(latest, highest string) *github.ReleaseableRepoResponse {
+	r := &github.ReleaseableRepoResponse{
+		Repo:    &gogithub.Repository{Name: gogithub.String("example1")},
+		Commits: []*gogithub.RepositoryCommit{{SHA: gogithub.String("c1"), Commit: &gogithub.Commit{Message: gogithub.String("feat: example")}}},
+	}
+
+	if latest != "" {
+		r.LatestTag = &gogithub.RepositoryTag{Name: gogithub.String(latest)}
+	}
+
+	if highest != "" {
+		r.HighestTag = &gogithub.RepositoryTag{Name: gogithub.String(highest)}
+	}
+
+	return r
+}
+
+func TestNewVersion(t *testing.T) {
+	tests := []struct {
+		name        string
+		latest      string
+		highest     string
+		expected    string
+		description string
+	}{
+		{name: "Highest greater than latest", latest: "v1.2.0", highest: "v2.0.0", expected: "v2.1.0", description: "minor, after existing v2.0.0"},
+		{name: "Highest is latest", latest: "v1.2.0", highest: "v1.2.0", expected: "v1.3.0", description: "minor"},
+		{name: "No tags", expected: "v0.1.0", description: "minor"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &config.Config{VersionScheme: version.SemVer{}}
+
+			newVersion, description, err := NewVersion(releaseableRepo(test.latest, test.highest), c, version.IncMinor)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, newVersion)
+			assert.Equal(t, test.description, description)
+		})
+	}
+}
+
+func TestValidateVersion(t *testing.T) {
+	tests := []struct {
+		name        string
+		tagTemplate string
+		tag         string
+		err         string
+	}{
+		{name: "Greater", tag: "v1.3.0"},
+		{name: "Not a version", tag: "banana", err: "invalid version 'banana'"},
+		{name: "Not the tag format", tagTemplate: "v{{ .Version }}", tag: "1.3.0", err: "invalid version '1.3.0'"},
+		{name: "Equal to highest", tag: "v1.2.0", err: "must be greater than existing v1.2.0"},
+		{name: "Less than highest", tag: "v1.1.9", err: "must be greater than existing v1.2.0"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &config.Config{VersionScheme: version.SemVer{}, TagTemplate: test.tagTemplate}
+
+			err := ValidateVersion(releaseableRepo("v1.1.0", "v1.2.0"), c, test.tag)
+			if test.err == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), test.err)
+		})
+	}
+}
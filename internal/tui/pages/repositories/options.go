@@ -11,6 +11,10 @@ type keyMap struct {
 	Refresh      key.Binding
 	More         key.Binding
 	ToggleAll    key.Binding
+	CycleVersion key.Binding
+	EditVersion  key.Binding
+	Confirm      key.Binding
+	Cancel       key.Binding
 	Quit         key.Binding
 }
 
@@ -32,6 +36,22 @@ func newKeyMap() *keyMap {
 			key.WithKeys("r"),
 			key.WithHelp("r", "refresh"),
 		),
+		CycleVersion: key.NewBinding(
+			key.WithKeys("v"),
+			key.WithHelp("v", "cycle version (major, minor, patch)"),
+		),
+		EditVersion: key.NewBinding(
+			key.WithKeys("e"),
+			key.WithHelp("e", "edit version"),
+		),
+		Confirm: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "confirm"),
+		),
+		Cancel: key.NewBinding(
+			key.WithKeys("esc"),
+			key.WithHelp("esc", "cancel"),
+		),
 		Quit: key.NewBinding(
 			key.WithKeys("q", "ctrl+c"),
 		),
@@ -4,17 +4,21 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"sync"
 
 	"github.com/NickHackman/releaser/internal/config"
 	"github.com/NickHackman/releaser/internal/github"
 	"github.com/NickHackman/releaser/internal/tui/bubbles/preview"
 	"github.com/NickHackman/releaser/internal/tui/bubbles/repository"
 	"github.com/NickHackman/releaser/internal/tui/colors"
+	"github.com/NickHackman/releaser/internal/version"
 	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/list"
 	"github.com/charmbracelet/bubbles/progress"
+	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"golang.org/x/sync/errgroup"
 )
 
 const (
@@ -22,13 +26,21 @@ const (
 	listWidth                = 75
 	increaseTerminalWidthMsg = "Increase width of terminal to display content."
 	refreshConfigMsg         = "Refreshing config..."
+	editVersionPrompt        = "Version: "
+	explicitChange           = "explicit"
 )
 
+// versionCycle order of changes when cycling the version of a repository.
+var versionCycle = []version.Change{version.IncMajor, version.IncMinor, version.IncPatch}
+
 type Model struct {
 	list     list.Model
 	progress progress.Model
 	preview  preview.Model
+	input    textinput.Model
 	keys     *keyMap
+	// editing whether the version of the current repository is being edited by input.
+	editing bool
 
 	gh      *github.Client
 	channel <-chan *github.ReleaseableRepoResponse
@@ -41,7 +53,10 @@ func New(gh *github.Client, config *config.Config) *Model {
 	delegate := repository.NewDelegate(gh, config)
 
 	list := list.NewModel([]list.Item{}, delegate, 0, 0)
-	list.Title = fmt.Sprintf("%s Repositories", strings.Title(config.Org))
+	list.Title = fmt.Sprintf("%s Repositories", strings.Title(strings.Join(config.Orgs, ", ")))
+	if config.Team != "" {
+		list.Title = fmt.Sprintf("%s/%s Repositories", strings.Title(config.Org), config.Team)
+	}
 	list.SetShowHelp(false)
 	list.Styles.Title = listTitleStyle
 
@@ -52,6 +67,8 @@ func New(gh *github.Client, config *config.Config) *Model {
 			keys.Publish,
 			keys.Refresh,
 			keys.ToggleAll,
+			keys.CycleVersion,
+			keys.EditVersion,
 		}
 	}
 
@@ -63,13 +80,17 @@ func New(gh *github.Client, config *config.Config) *Model {
 		}
 	}
 
+	input := textinput.NewModel()
+	input.Prompt = editVersionPrompt
+
 	m := &Model{
 		list:     list,
+		input:    input,
 		progress: progress.NewModel(progress.WithoutPercentage(), progress.WithGradient(colors.ProgressStart, colors.ProgressEnd)),
 		preview:  preview.New(),
 		keys:     keys,
 		gh:       gh,
-		channel:  fetch(config, gh, config.Org),
+		channel:  fetch(config, gh),
 		config:   config,
 	}
 
@@ -78,7 +99,7 @@ func New(gh *github.Client, config *config.Config) *Model {
 }
 
 func (m Model) Init() tea.Cmd {
-	return loadRepositoriesCmd(m.channel, m.config)
+	return loadRepositoriesCmd(m.channel, m.gh, m.config)
 }
 
 func (m *Model) SetSize(width, height int) {
@@ -122,15 +143,37 @@ func (m *Model) refreshPreview() {
 		return
 	}
 
-	m.preview.SetContent(current.Preview, current.Branch, current.Version)
+	m.preview.SetContent(current.Preview, current.Branch, current.Version, current.Change)
 }
 
 func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	// Input consumes all keys while editing
+	if msg, ok := msg.(tea.KeyMsg); ok && m.editing {
+		return m.updateEditing(msg)
+	}
+
 	cmds := m.updateSubmodels(msg)
 
 	switch msg := msg.(type) {
 	case errorCmd:
 		cmds = append(cmds, m.list.NewStatusMessage(msg.Error()))
+	case notesMsg:
+		for index, item := range m.list.Items() {
+			current, ok := item.(repository.Item)
+			// Notes of a version that has since changed are stale
+			if !ok || current.Repo.GetOwner().GetLogin() != msg.owner || current.Repo.GetName() != msg.name || current.Version != msg.version {
+				continue
+			}
+
+			preview := Body(current.ReleaseableRepoResponse, m.config, msg.notes)
+			if msg.err != nil {
+				preview += fmt.Sprintf("\n\nError: %v", msg.err)
+			}
+
+			cmds = append(cmds, m.list.SetItem(index, current.SetNotes(msg.notes, preview, msg.err)))
+		}
+
+		m.refreshPreview()
 	case tea.WindowSizeMsg:
 		m.SetSize(msg.Width, msg.Height)
 	case progress.FrameMsg:
@@ -143,11 +186,18 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		index := len(m.list.Items()) - 1
 		percent := float64(m.repos) / float64(msg.Total)
 		cmds = append(cmds,
-			loadRepositoriesCmd(m.channel, m.config),
+			loadRepositoriesCmd(m.channel, m.gh, m.config),
 			m.progress.SetPercent(percent),
 			m.list.InsertItem(index, msg),
 		)
 
+		// Loaded every repository
+		if m.repos == int(msg.Total) {
+			if status := rateStatus(m.gh.Rate()); status != "" {
+				cmds = append(cmds, m.list.NewStatusMessage(status))
+			}
+		}
+
 		// Refresh preview every time, since the current item may change
 		m.refreshPreview()
 	case tea.KeyMsg:
@@ -171,9 +221,35 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 
 			m.repos = 0
-			m.channel = fetch(m.config, m.gh, m.config.Org)
+			m.channel = fetch(m.config, m.gh)
 			m.preview.SetLoading()
 			cmds = append(cmds, m.progress.SetPercent(0), m.list.SetItems([]list.Item{}), m.Init())
+		case key.Matches(msg, m.keys.CycleVersion):
+			current, ok := m.list.SelectedItem().(repository.Item)
+			if !ok {
+				break
+			}
+
+			change := cycleChange(current.Override, m.config.VersionChange)
+			newVersion, description, err := NewVersion(current.ReleaseableRepoResponse, m.config, change)
+			if err != nil {
+				description = err.Error()
+			}
+
+			current = current.SetVersion(newVersion, description, change)
+			cmds = append(cmds, m.list.SetItem(m.list.Index(), current), generateNotesCmd(m.gh, m.config, current))
+			m.refreshPreview()
+		case key.Matches(msg, m.keys.EditVersion):
+			current, ok := m.list.SelectedItem().(repository.Item)
+			if !ok {
+				break
+			}
+
+			m.editing = true
+			m.input.SetValue(current.Version)
+			m.input.CursorEnd()
+			cmds = append(cmds, m.input.Focus())
+			m.SetSize(m.config.Size())
 		case key.Matches(msg, m.keys.ToggleAll):
 			var newItems []list.Item
 			for _, item := range m.list.Items() {
@@ -199,6 +275,68 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return m, tea.Batch(cmds...)
 }
 
+// updateEditing handles keys while editing the version of the current repository.
+func (m Model) updateEditing(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+
+	switch {
+	case key.Matches(msg, m.keys.Cancel):
+		m.stopEditing()
+	case key.Matches(msg, m.keys.Confirm):
+		current, ok := m.list.SelectedItem().(repository.Item)
+		if !ok {
+			m.stopEditing()
+			break
+		}
+
+		newVersion := strings.TrimSpace(m.input.Value())
+		if err := ValidateVersion(current.ReleaseableRepoResponse, m.config, newVersion); err != nil {
+			cmd = m.list.NewStatusMessage(err.Error())
+			break
+		}
+
+		m.stopEditing()
+		current = current.SetVersion(newVersion, explicitChange, current.Override)
+		cmd = tea.Batch(m.list.SetItem(m.list.Index(), current), generateNotesCmd(m.gh, m.config, current))
+		m.refreshPreview()
+	default:
+		m.input, cmd = m.input.Update(msg)
+	}
+
+	return m, cmd
+}
+
+func (m *Model) stopEditing() {
+	m.editing = false
+	m.input.Blur()
+	m.input.Reset()
+	m.SetSize(m.config.Size())
+}
+
+// rateStatus the remaining requests of the rate limit budget of GitHub, empty when GitHub hasn't reported a budget.
+func rateStatus(rate github.Rate) string {
+	if rate.Limit == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("%d/%d GitHub requests left until %s", rate.Remaining, rate.Limit, rate.Reset.Format("15:04"))
+}
+
+// cycleChange the change after current in versionCycle, when current is not overridden the configured change is used.
+func cycleChange(current, configured version.Change) version.Change {
+	if current == "" {
+		current = configured
+	}
+
+	for i, change := range versionCycle {
+		if change == current {
+			return versionCycle[(i+1)%len(versionCycle)]
+		}
+	}
+
+	return versionCycle[0]
+}
+
 func (m *Model) updateSubmodels(msg tea.Msg) []tea.Cmd {
 	var cmd tea.Cmd
 	var cmds []tea.Cmd
@@ -221,11 +359,22 @@ func (m *Model) updateSubmodels(msg tea.Msg) []tea.Cmd {
 
 	cmds = append(cmds, cmd)
 
+	// Cursor blinking
+	if m.editing {
+		m.input, cmd = m.input.Update(msg)
+		cmds = append(cmds, cmd)
+	}
+
 	return cmds
 }
 
 func (m Model) statusView() string {
 	helpView := m.list.Styles.HelpStyle.Render(m.list.Help.View(m.list))
+	if m.editing {
+		helpView = m.list.Styles.HelpStyle.Render(m.list.Help.ShortHelpView([]key.Binding{m.keys.Confirm, m.keys.Cancel}))
+		helpView = lipgloss.JoinVertical(lipgloss.Left, inputStyle.Render(m.input.View()), helpView)
+	}
+
 	return lipgloss.JoinVertical(lipgloss.Left, helpView, m.progress.View())
 }
 
@@ -239,18 +388,10 @@ func (m Model) View() string {
 	return lipgloss.JoinVertical(lipgloss.Left, top, m.statusView())
 }
 
-func fetch(config *config.Config, gh *github.Client, org string) <-chan *github.ReleaseableRepoResponse {
+func fetch(config *config.Config, gh *github.Client) <-chan *github.ReleaseableRepoResponse {
 	ctx, cancel := context.WithTimeout(context.Background(), config.Timeout)
 
-	var channel <-chan *github.ReleaseableRepoResponse
-	var callback func() error
-
-	switch {
-	case config.Username == org:
-		channel, callback = gh.ReleaseableReposByUser(ctx, config.Username, config.Branch)
-	default:
-		channel, callback = gh.ReleasableReposByOrg(ctx, org, config.Branch)
-	}
+	channel, callback := Discover(ctx, gh, config)
 
 	go func() {
 		defer cancel()
@@ -262,3 +403,56 @@ func fetch(config *config.Config, gh *github.Client, org string) <-chan *github.
 
 	return channel
 }
+
+// Discover the releasable repositories of every organization, the user or the team of config as one channel along
+// with the function to run as a goroutine to acquire them.
+func Discover(ctx context.Context, gh *github.Client, config *config.Config) (<-chan *github.ReleaseableRepoResponse, func() error) {
+	c := make(chan *github.ReleaseableRepoResponse)
+
+	errGrp, ctx := errgroup.WithContext(ctx)
+
+	return c, func() error {
+		defer close(c)
+
+		var mu sync.Mutex
+		totals := make(map[string]int32, len(config.Orgs))
+
+		for _, org := range config.Orgs {
+			org := org
+
+			var channel <-chan *github.ReleaseableRepoResponse
+			var callback func() error
+
+			switch {
+			case config.Username == org:
+				channel, callback = gh.ReleaseableReposByUser(ctx, config.Username, config.Branch)
+			case config.Team != "":
+				channel, callback = gh.ReleasableReposByTeam(ctx, org, config.Team, config.Branch)
+			default:
+				channel, callback = gh.ReleasableReposByOrg(ctx, org, config.Branch)
+			}
+
+			errGrp.Go(callback)
+			errGrp.Go(func() error {
+				for r := range channel {
+					mu.Lock()
+					totals[org] = r.Total
+
+					// Total of every organization whose repositories have started loading
+					var total int32
+					for _, t := range totals {
+						total += t
+					}
+					mu.Unlock()
+
+					r.Total = total
+					c <- r
+				}
+
+				return nil
+			})
+		}
+
+		return errGrp.Wait()
+	}
+}
@@ -0,0 +1,108 @@
+package repositories
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/NickHackman/releaser/internal/config"
+	"github.com/NickHackman/releaser/internal/github"
+	"github.com/NickHackman/releaser/internal/version"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateStatus(t *testing.T) {
+	reset := time.Date(2022, time.January, 31, 12, 30, 0, 0, time.Local)
+
+	assert.Equal(t, "4999/5000 GitHub requests left until 12:30", rateStatus(github.Rate{Limit: 5000, Remaining: 4999, Reset: reset}))
+	assert.Empty(t, rateStatus(github.Rate{}))
+}
+
+func TestCycleChange(t *testing.T) {
+	tests := []struct {
+		name       string
+		current    version.Change
+		configured version.Change
+		expected   version.Change
+	}{
+		{name: "Configured", configured: version.IncMinor, expected: version.IncPatch},
+		{name: "Wraps around", configured: version.IncPatch, expected: version.IncMajor},
+		{name: "Overridden", current: version.IncMajor, configured: version.IncPatch, expected: version.IncMinor},
+		{name: "Overridden wraps around", current: version.IncPatch, configured: version.IncMinor, expected: version.IncMajor},
+		{name: "Not in the cycle", configured: version.IncAuto, expected: version.IncMajor},
+		{name: "Pre-release", configured: version.PreRelease("rc"), expected: version.IncMajor},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, cycleChange(test.current, test.configured))
+		})
+	}
+}
+
+// newTestClient a client of the GitHub Enterprise host served by handler.
+func newTestClient(t *testing.T, handler http.HandlerFunc) *github.Client {
+	server := httptest.NewTLSServer(handler)
+	t.Cleanup(server.Close)
+
+	// Trust the certificate of server
+	transport := http.DefaultTransport
+	http.DefaultTransport = server.Client().Transport
+	t.Cleanup(func() { http.DefaultTransport = transport })
+
+	u, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	gh, err := github.New().Host(u.Host).Token("token").Build()
+	require.NoError(t, err)
+
+	return gh
+}
+
+func TestDiscover(t *testing.T) {
+	gh := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v3/orgs/example/repos":
+			_, _ = w.Write([]byte(`[
+				{"name": "example1", "default_branch": "main", "owner": {"login": "example"}},
+				{"name": "example2", "default_branch": "main", "owner": {"login": "example"}}
+			]`))
+		case "/api/v3/orgs/other/repos":
+			_, _ = w.Write([]byte(`[{"name": "example1", "default_branch": "main", "owner": {"login": "other"}}]`))
+		case "/api/v3/repos/example/example1/tags", "/api/v3/repos/example/example2/tags", "/api/v3/repos/other/example1/tags",
+			"/api/v3/repos/example/example1/branches", "/api/v3/repos/example/example2/branches", "/api/v3/repos/other/example1/branches":
+			_, _ = w.Write([]byte(`[]`))
+		case "/api/v3/repos/example/example1/commits", "/api/v3/repos/example/example2/commits", "/api/v3/repos/other/example1/commits":
+			_, _ = w.Write([]byte(`[{"sha": "c1", "commit": {"message": "feat: example"}}]`))
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	c := &config.Config{Orgs: []string{"example", "other"}, Username: "octocat", Timeout: time.Minute}
+
+	channel, callback := Discover(context.Background(), gh, c)
+
+	errs := make(chan error, 1)
+	go func() { errs <- callback() }()
+
+	var repos []string
+	var total int32
+	for r := range channel {
+		repos = append(repos, r.Repo.GetOwner().GetLogin()+"/"+r.Repo.GetName())
+		total = r.Total
+	}
+
+	require.NoError(t, <-errs)
+
+	// Repositories of the same name are told apart by their owner
+	assert.ElementsMatch(t, []string{"example/example1", "example/example2", "other/example1"}, repos)
+
+	// By the last repository every organization has started loading
+	assert.Equal(t, int32(3), total)
+}
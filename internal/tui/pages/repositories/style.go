@@ -8,4 +8,5 @@ import (
 var (
 	listTitleStyle = lipgloss.NewStyle().Padding(1).Background(colors.Title).Bold(true)
 	listStyle      = lipgloss.NewStyle().PaddingRight(1).Width(listWidth).MaxWidth(listWidth)
+	inputStyle     = lipgloss.NewStyle().PaddingLeft(2)
 )
@@ -0,0 +1,33 @@
+package teams
+
+import (
+	"context"
+
+	"github.com/NickHackman/releaser/internal/config"
+	"github.com/NickHackman/releaser/internal/github"
+	"github.com/NickHackman/releaser/internal/tui/bubbles/team"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type errorCmd error
+
+type loadedTeamsCmd []team.Item
+
+func loadTeamsCmd(gh *github.Client, config *config.Config) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), config.Timeout)
+		defer cancel()
+
+		teams, err := gh.Teams(ctx, config.Org)
+		if err != nil {
+			return errorCmd(err)
+		}
+
+		items := loadedTeamsCmd{team.Item{Name: allRepositories, Description: "Every repository of " + config.Org}}
+		for _, t := range teams {
+			items = append(items, team.Item{Slug: t.Slug, Name: t.Name, Description: t.Description})
+		}
+
+		return items
+	}
+}
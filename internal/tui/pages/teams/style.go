@@ -0,0 +1,10 @@
+package teams
+
+import (
+	"github.com/NickHackman/releaser/internal/tui/colors"
+	"github.com/charmbracelet/lipgloss"
+)
+
+var (
+	teamListTitleStyle = lipgloss.NewStyle().Padding(1).Background(colors.Title).Bold(true)
+)
@@ -0,0 +1,106 @@
+package teams
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/NickHackman/releaser/internal/config"
+	"github.com/NickHackman/releaser/internal/github"
+	"github.com/NickHackman/releaser/internal/tui/bubbles/team"
+	"github.com/NickHackman/releaser/internal/tui/pages/repositories"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+const (
+	allRepositories = "All repositories"
+)
+
+// Model page to select a team of an organization whose repositories are released.
+type Model struct {
+	list list.Model
+	keys *keyMap
+
+	gh     *github.Client
+	config *config.Config
+}
+
+func New(gh *github.Client, config *config.Config) *Model {
+	keys := newKeyMap()
+
+	list := list.NewModel([]list.Item{}, team.Delegate{}, 0, 0)
+	list.Title = fmt.Sprintf("%s Teams", strings.Title(config.Org))
+	list.Styles.Title = teamListTitleStyle
+	list.AdditionalFullHelpKeys = func() []key.Binding {
+		return []key.Binding{keys.Selection, keys.Refresh}
+	}
+	list.AdditionalShortHelpKeys = func() []key.Binding {
+		return []key.Binding{keys.Selection, keys.Refresh}
+	}
+
+	m := &Model{
+		list:   list,
+		keys:   keys,
+		gh:     gh,
+		config: config,
+	}
+
+	m.list.SetSize(config.Size())
+	return m
+}
+
+func (m Model) Init() tea.Cmd {
+	return tea.Batch(m.list.StartSpinner(), loadTeamsCmd(m.gh, m.config))
+}
+
+func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmds []tea.Cmd
+	var cmd tea.Cmd
+
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.config.SetSize(msg.Width, msg.Height)
+		m.list.SetSize(msg.Width, msg.Height)
+	case errorCmd:
+		m.list.StopSpinner()
+		cmds = append(cmds, m.list.NewStatusMessage(msg.Error()))
+	case loadedTeamsCmd:
+		m.list.StopSpinner()
+
+		items := make([]list.Item, 0, len(msg))
+		for _, item := range msg {
+			items = append(items, item)
+		}
+
+		cmds = append(cmds, m.list.SetItems(items))
+	case tea.KeyMsg:
+		if m.list.FilterState() == list.Filtering {
+			break
+		}
+
+		switch {
+		case key.Matches(msg, m.keys.Selection):
+			team, ok := m.list.SelectedItem().(team.Item)
+			if !ok {
+				return m, nil
+			}
+
+			m.config.Team = team.Slug
+
+			repositories := repositories.New(m.gh, m.config)
+			return repositories, repositories.Init()
+		case key.Matches(msg, m.keys.Refresh):
+			cmds = append(cmds, m.list.SetItems([]list.Item{}), m.Init())
+		}
+	}
+
+	m.list, cmd = m.list.Update(msg)
+	cmds = append(cmds, cmd)
+
+	return m, tea.Batch(cmds...)
+}
+
+func (m Model) View() string {
+	return m.list.View()
+}
@@ -0,0 +1,110 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NickHackman/releaser/internal/config"
+	"github.com/NickHackman/releaser/internal/github"
+	"github.com/NickHackman/releaser/internal/plan"
+	"github.com/charmbracelet/lipgloss"
+)
+
+var labelStyle = lipgloss.NewStyle().Bold(true).Width(16)
+
+// finishPlan writes the releases to the plan file when provided, otherwise prints them.
+func finishPlan(config *config.Config, releases []*github.RepositoryRelease) error {
+	if config.PlanFile == "" {
+		printPlan(releases, config.CreateReleaseBranch)
+		return nil
+	}
+
+	p := &plan.Plan{Host: config.Host, CreateReleaseBranch: config.CreateReleaseBranch, Releases: releases}
+	if err := p.Write(config.PlanFile); err != nil {
+		return err
+	}
+
+	fmt.Printf("Wrote %d release(s) to %s\n\nRun:\nreleaser apply %s\n", len(releases), config.PlanFile, config.PlanFile)
+	return nil
+}
+
+// Apply creates the releases of the plan, refusing when any release can no longer be created as planned.
+func Apply(gh *github.Client, config *config.Config, p *plan.Plan) error {
+	if p.Host != config.Host {
+		return fmt.Errorf("plan is for host %s not %s, provide --host %s", p.Host, config.Host, p.Host)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), config.Timeout)
+	defer cancel()
+
+	if errs := gh.VerifyReleases(ctx, p.Releases); len(errs) > 0 {
+		for _, err := range errs {
+			fmt.Println(errStyle.Render("Error: " + err.Error()))
+		}
+
+		return fmt.Errorf("refusing to apply plan, %d release(s) changed since planned", len(errs))
+	}
+
+	if config.DryRun {
+		printPlan(p.Releases, p.CreateReleaseBranch)
+		return nil
+	}
+
+	journal := github.NewJournal(config.Host)
+	response := gh.CreateReleases(ctx, p.Releases, github.CreateOptions{CreateReleaseBranch: p.CreateReleaseBranch, Atomic: config.Atomic, Journal: journal, CI: config.CI})
+
+	if len(response) > 0 {
+		printReleases(response)
+	} else {
+		fmt.Println("No releases were created.")
+	}
+
+	return finishRun(journal)
+}
+
+// printPlan prints the releases that would be created without creating them.
+func printPlan(releases []*github.RepositoryRelease, createReleaseBranch bool) {
+	if len(releases) == 0 {
+		fmt.Println("Dry run: no releases would be created.")
+		return
+	}
+
+	fmt.Printf("Dry run: %d release(s) would be created.\n\n", len(releases))
+
+	for _, release := range releases {
+		fullName := titleStyle.Render("## " + release.Owner + "/" + release.Name)
+		version := versionStyle.Render(release.Version)
+
+		fmt.Printf("%s %s %s\n", fullName, version, changeStyle.Render(release.Change))
+
+		baseline := release.BaselineTag
+		if baseline == "" {
+			baseline = "none"
+		}
+
+		releaseBranch := "none"
+		if createReleaseBranch {
+			releaseBranch = release.Version
+		}
+
+		fmt.Println(labelStyle.Render("Baseline tag:") + baseline)
+		fmt.Println(labelStyle.Render("Target:") + release.TargetSHA + " (" + release.Branch + ")")
+		fmt.Println(labelStyle.Render("Release branch:") + releaseBranch)
+		fmt.Println(labelStyle.Render("Pre-release:") + fmt.Sprint(release.Prerelease))
+		fmt.Println(labelStyle.Render("Draft:") + fmt.Sprint(release.Draft))
+
+		for _, file := range release.Files {
+			fmt.Println(labelStyle.Render("Version file:") + file.Path)
+		}
+
+		for _, asset := range release.Assets {
+			fmt.Println(labelStyle.Render("Asset:") + asset)
+		}
+
+		if release.Checksums && len(release.Assets) > 0 {
+			fmt.Println(labelStyle.Render("Asset:") + github.ChecksumsFilename)
+		}
+
+		fmt.Printf("\n%s\n", release.Body)
+	}
+}
@@ -0,0 +1,117 @@
+package tui
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/NickHackman/releaser/internal/config"
+	"github.com/NickHackman/releaser/internal/github"
+	"github.com/NickHackman/releaser/internal/plan"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestClient a client of the GitHub Enterprise host served by handler, any request other than a GET fails the test.
+func newTestClient(t *testing.T, handler http.HandlerFunc) (*github.Client, string) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("unexpected write %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		handler(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	// Trust the certificate of server
+	transport := http.DefaultTransport
+	http.DefaultTransport = server.Client().Transport
+	t.Cleanup(func() { http.DefaultTransport = transport })
+
+	u, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	gh, err := github.New().Host(u.Host).Token("token").Build()
+	require.NoError(t, err)
+
+	return gh, u.Host
+}
+
+var ansi = regexp.MustCompile(`\x1b\[[0-9;]*m`)
+
+// captureStdout what fn prints without styles.
+func captureStdout(t *testing.T, fn func()) string {
+	r, w, err := os.Pipe()
+	require.NoError(t, err)
+
+	stdout := os.Stdout
+	os.Stdout = w
+	defer func() { os.Stdout = stdout }()
+
+	output := make(chan string)
+	go func() {
+		content, _ := ioutil.ReadAll(r)
+		output <- string(content)
+	}()
+
+	fn()
+	w.Close()
+
+	return ansi.ReplaceAllString(<-output, "")
+}
+
+func TestApplyDryRun(t *testing.T) {
+	gh, host := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v3/repos/example/example1/branches/main":
+			_, _ = w.Write([]byte(`{"name": "main", "commit": {"sha": "abcdef1234567890"}}`))
+		case "/api/v3/repos/example/example1/git/ref/tags/v1.3.0":
+			w.WriteHeader(http.StatusNotFound)
+		case "/api/v3/repos/example/example1/releases":
+			_, _ = w.Write([]byte(`[]`))
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+	})
+
+	c := &config.Config{Host: host, DryRun: true, Timeout: time.Minute}
+	p := &plan.Plan{
+		Host: host,
+		Releases: []*github.RepositoryRelease{
+			{Owner: "example", Name: "example1", Version: "v1.3.0", Change: "minor", BaselineTag: "v1.2.0", TargetSHA: "abcdef1234567890", Branch: "main", Body: "feat: example\n"},
+		},
+	}
+
+	var err error
+	output := captureStdout(t, func() { err = Apply(gh, c, p) })
+	require.NoError(t, err)
+
+	assert.Contains(t, output, "Dry run: 1 release(s) would be created.")
+	assert.Contains(t, output, "## example/example1 v1.3.0")
+	assert.Contains(t, output, "abcdef1234567890 (main)")
+	assert.Contains(t, output, "v1.2.0")
+}
+
+func TestPrintPlan(t *testing.T) {
+	releases := []*github.RepositoryRelease{
+		{Owner: "example", Name: "example1", Version: "v1.3.0", TargetSHA: "abcdef1234567890", Branch: "main"},
+		{Owner: "other", Name: "example2", Version: "v0.1.0", TargetSHA: "1234567890abcdef", Branch: "develop"},
+	}
+
+	output := captureStdout(t, func() { printPlan(releases, true) })
+
+	assert.Contains(t, output, "Dry run: 2 release(s) would be created.")
+	assert.Contains(t, output, "## example/example1 v1.3.0")
+	assert.Contains(t, output, "abcdef1234567890 (main)")
+	assert.Contains(t, output, "## other/example2 v0.1.0")
+	assert.Contains(t, output, "1234567890abcdef (develop)")
+
+	assert.Equal(t, "Dry run: no releases would be created.\n", captureStdout(t, func() { printPlan(nil, false) }))
+}
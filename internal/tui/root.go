@@ -9,7 +9,6 @@ import (
 	"github.com/NickHackman/releaser/internal/tui/colors"
 	"github.com/NickHackman/releaser/internal/tui/pages/organizations"
 	"github.com/NickHackman/releaser/internal/tui/pages/repositories"
-	"github.com/NickHackman/releaser/internal/version"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 )
@@ -23,8 +22,14 @@ func Execute(gh *github.Client, config *config.Config) error {
 	releasesChan := make(chan []*github.RepositoryReleaseResponse, 1)
 	config.Terminal.Releases = releasesChan
 
+	planChan := make(chan []*github.RepositoryRelease, 1)
+	config.Terminal.Plan = planChan
+
+	journalChan := make(chan *github.Journal, 1)
+	config.Terminal.Journal = journalChan
+
 	var page tea.Model = organizations.New(gh, config)
-	if config.Org != "" {
+	if len(config.Orgs) > 0 {
 		page = repositories.New(gh, config)
 	}
 
@@ -37,6 +42,10 @@ func Execute(gh *github.Client, config *config.Config) error {
 		if len(releases) > 0 {
 			printReleases(releases)
 		}
+
+		return finishRun(<-journalChan)
+	case releases := <-planChan:
+		return finishPlan(config, releases)
 	default:
 		fmt.Println("No releases were created.")
 	}
@@ -45,19 +54,13 @@ func Execute(gh *github.Client, config *config.Config) error {
 }
 
 func noninteractiveReleases(gh *github.Client, config *config.Config) error {
-	var channel <-chan *github.ReleaseableRepoResponse
-	var callback func() error
+	if len(config.Orgs) == 0 {
+		config.Orgs = []string{config.Username}
+	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), config.Timeout)
 
-	var owner string
-	if config.Org == "" {
-		owner = config.Username
-		channel, callback = gh.ReleaseableReposByUser(ctx, config.Username, config.Branch)
-	} else {
-		owner = config.Org
-		channel, callback = gh.ReleasableReposByOrg(ctx, config.Org, config.Branch)
-	}
+	channel, callback := repositories.Discover(ctx, gh, config)
 
 	go func() {
 		defer cancel()
@@ -71,39 +74,55 @@ func noninteractiveReleases(gh *github.Client, config *config.Config) error {
 	var releases []*github.RepositoryRelease
 	for repo := range channel {
 		name := repo.Repo.GetName()
-		if !config.IsRepositoryToRelease(name) {
+		if !config.IsRepositoryToRelease(repo.Repo.GetOwner().GetLogin(), name) {
 			continue
 		}
 
-		newVersion := version.New(repo.LatestTag.GetName(), config.VersionChange)
-		description := repositories.PreviewContent(repo, config.Template)
+		newVersion, change, err := repositories.NewVersion(repo, config, config.VersionChange)
+		if err != nil {
+			return err
+		}
+
+		if err := repositories.PullRequests(gh, repo, config); err != nil {
+			return err
+		}
+
+		notes, err := repositories.GenerateNotes(gh, repo, config, newVersion)
+		if err != nil {
+			return err
+		}
+
+		description := repositories.Body(repo, config, notes)
+
+		releases = append(releases, repositories.NewRelease(repo, config, newVersion, change, description))
+	}
 
-		releases = append(releases, &github.RepositoryRelease{
-			Name:      name,
-			Version:   newVersion,
-			Body:      description,
-			TargetSHA: repo.Commits[0].GetSHA(),
-		})
+	if config.DryRun {
+		return finishPlan(config, releases)
 	}
 
 	ctx, cancel = context.WithTimeout(context.Background(), config.Timeout)
 	defer cancel()
 
-	response := gh.CreateReleases(ctx, owner, releases, config.CreateReleaseBranch)
+	journal := github.NewJournal(config.Host)
+	response := gh.CreateReleases(ctx, releases, github.CreateOptions{CreateReleaseBranch: config.CreateReleaseBranch, Atomic: config.Atomic, Journal: journal, CI: config.CI})
 
 	if len(response) > 0 {
 		printReleases(response)
 	} else {
 		fmt.Println("No releases were created.")
 	}
-	return nil
+
+	return finishRun(journal)
 }
 
 var (
 	titleStyle   = lipgloss.NewStyle().Foreground(colors.Title).Bold(true)
 	urlStyle     = lipgloss.NewStyle().Foreground(colors.URL)
 	versionStyle = lipgloss.NewStyle().Foreground(colors.Selected)
+	changeStyle  = lipgloss.NewStyle().Faint(true)
 	errStyle     = lipgloss.NewStyle().Foreground(lipgloss.Color("#ff0000"))
+	warningStyle = lipgloss.NewStyle().Foreground(colors.Pending)
 )
 
 func printReleases(releases []*github.RepositoryReleaseResponse) {
@@ -111,7 +130,7 @@ func printReleases(releases []*github.RepositoryReleaseResponse) {
 		fullName := titleStyle.Render("## " + release.Owner + "/" + release.Name)
 		version := versionStyle.Render(release.Version)
 
-		fmt.Printf("%s %s\n", fullName, version)
+		fmt.Printf("%s %s %s\n", fullName, version, changeStyle.Render(release.Change))
 		fmt.Print(release.Body)
 
 		if release.IsError() {
@@ -120,6 +139,10 @@ func printReleases(releases []*github.RepositoryReleaseResponse) {
 			return
 		}
 
+		if release.Warning != "" {
+			fmt.Println(warningStyle.Render("Warning: " + release.Warning))
+		}
+
 		url := urlStyle.Render(release.URL)
 		fmt.Printf("%s\n\n", url)
 	}
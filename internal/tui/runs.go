@@ -0,0 +1,75 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NickHackman/releaser/internal/config"
+	"github.com/NickHackman/releaser/internal/github"
+	"github.com/NickHackman/releaser/internal/runs"
+)
+
+// finishRun saves the journal of the run to roll it back later.
+func finishRun(journal *github.Journal) error {
+	if len(journal.Entries) == 0 {
+		return nil
+	}
+
+	dir, err := config.RunsDir()
+	if err != nil {
+		return err
+	}
+
+	if err := runs.Create(dir, journal); err != nil {
+		return err
+	}
+
+	fmt.Printf("Run %s\n\nUndo with:\nreleaser rollback %s\n", journal.ID, journal.ID)
+	return nil
+}
+
+// Rollback deletes everything created by run id, what fails to roll back is kept to try again.
+func Rollback(gh *github.Client, c *config.Config, id string) error {
+	dir, err := config.RunsDir()
+	if err != nil {
+		return err
+	}
+
+	journal, err := runs.Read(dir, id)
+	if err != nil {
+		return err
+	}
+
+	if journal.Host != c.Host {
+		return fmt.Errorf("run %s is for host %s not %s, provide --host %s", id, journal.Host, c.Host, journal.Host)
+	}
+
+	if c.DryRun {
+		fmt.Printf("Dry run: %d change(s) would be rolled back.\n\n", len(journal.Entries))
+
+		for i := len(journal.Entries) - 1; i >= 0; i-- {
+			fmt.Println(journal.Entries[i])
+		}
+
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), c.Timeout)
+	defer cancel()
+
+	errs := gh.Rollback(ctx, journal)
+	for _, err := range errs {
+		fmt.Println(errStyle.Render("Error: " + err.Error()))
+	}
+
+	if err := runs.Save(dir, journal); err != nil {
+		return err
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to roll back %d change(s) of run %s", len(errs), id)
+	}
+
+	fmt.Printf("Rolled back run %s.\n", id)
+	return nil
+}
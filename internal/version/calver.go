@@ -0,0 +1,295 @@
+package version
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type calVerToken string
+
+// Tokens from https://calver.org with the addition of N, a counter that starts at 1.
+const (
+	fullYear    calVerToken = "YYYY"
+	shortYear   calVerToken = "YY"
+	paddedYear  calVerToken = "0Y"
+	month       calVerToken = "MM"
+	paddedMonth calVerToken = "0M"
+	week        calVerToken = "WW"
+	paddedWeek  calVerToken = "0W"
+	day         calVerToken = "DD"
+	paddedDay   calVerToken = "0D"
+	major       calVerToken = "MAJOR"
+	minor       calVerToken = "MINOR"
+	micro       calVerToken = "MICRO"
+	counter     calVerToken = "N"
+)
+
+// calVerTokens ordered longest first so MICRO is not parsed as MM.
+var calVerTokens = []calVerToken{major, minor, micro, fullYear, shortYear, paddedYear, month, paddedMonth, week, paddedWeek, day, paddedDay, counter}
+
+// CalVer Calendar Versioning https://calver.org.
+//
+// YYYY.0M.MICRO   2022.01.0 -> 2022.01.1 -> 2022.02.0
+// YYYY.MM.DD.N    2022.1.31.1 -> 2022.1.31.2 -> 2022.2.1.1
+//
+// Counters (MAJOR, MINOR, MICRO, N) increment when the date is unchanged, otherwise MINOR, MICRO and N reset.
+// The Change determines which counter is incremented falling back to the least significant.
+type CalVer struct {
+	tokens []calVerToken
+	// literals separators between tokens, literals[i] precedes tokens[i] and the last follows all tokens.
+	literals []string
+	pattern  *regexp.Regexp
+	// search finds the version surrounded by a prefix and suffix in a tag.
+	search *regexp.Regexp
+	now    func() time.Time
+}
+
+// NewCalVer creates a CalVer from format, now is used to determine the current date.
+func NewCalVer(format string, now func() time.Time) (*CalVer, error) {
+	c := &CalVer{now: now}
+
+	var literal strings.Builder
+	var pattern strings.Builder
+
+	for rest := format; rest != ""; {
+		token, ok := matchToken(rest)
+		if !ok {
+			literal.WriteByte(rest[0])
+			pattern.WriteString(regexp.QuoteMeta(rest[:1]))
+			rest = rest[1:]
+			continue
+		}
+
+		c.literals = append(c.literals, literal.String())
+		c.tokens = append(c.tokens, token)
+		literal.Reset()
+
+		pattern.WriteString("(" + token.pattern() + ")")
+		rest = rest[len(token):]
+	}
+
+	c.literals = append(c.literals, literal.String())
+
+	if len(c.tokens) == 0 {
+		return nil, fmt.Errorf("invalid calendar version format '%s' expected tokens such as YYYY, 0M, MICRO", format)
+	}
+
+	c.pattern = regexp.MustCompile("^" + pattern.String() + "$")
+	c.search = regexp.MustCompile("^(.*?)(" + pattern.String() + ")(.*)$")
+	return c, nil
+}
+
+func matchToken(s string) (calVerToken, bool) {
+	for _, token := range calVerTokens {
+		if strings.HasPrefix(s, string(token)) {
+			return token, true
+		}
+	}
+
+	return "", false
+}
+
+func (c *CalVer) Next(latest string, change Change, messages ...string) string {
+	if change == IncAuto {
+		change, _ = Infer(messages)
+	}
+
+	now := c.now()
+	values := make([]int, len(c.tokens))
+
+	previous, ok := c.parse(latest)
+
+	sameDate := ok
+	for i, token := range c.tokens {
+		if !token.isCounter() {
+			values[i] = token.date(now)
+			sameDate = sameDate && previous[i] == values[i]
+		}
+	}
+
+	if sameDate {
+		c.increment(previous, change)
+	}
+
+	for i, token := range c.tokens {
+		switch {
+		case token.isCounter() && ok && (sameDate || token == major):
+			values[i] = previous[i]
+		case token == counter:
+			values[i] = 1
+		}
+	}
+
+	return c.render(values)
+}
+
+// increment the counter determined by change in values, resets the less significant counters.
+func (c *CalVer) increment(values []int, change Change) {
+	target := -1
+
+	for i, token := range c.tokens {
+		if !token.isCounter() {
+			continue
+		}
+
+		if (change == IncMajor && token == major) || (change == IncMinor && token == minor) || (change == IncPatch && token == micro) {
+			target = i
+			break
+		}
+
+		// Fallback to the least significant counter
+		target = i
+	}
+
+	if target == -1 {
+		return
+	}
+
+	values[target]++
+
+	for i := target + 1; i < len(c.tokens); i++ {
+		switch c.tokens[i] {
+		case minor, micro:
+			values[i] = 0
+		case counter:
+			values[i] = 1
+		}
+	}
+}
+
+func (c *CalVer) Valid(version string) bool {
+	_, ok := c.parse(version)
+	return ok
+}
+
+// Compare compares tokens in the order of the format, the most significant token is expected first.
+func (c *CalVer) Compare(a, b string) (int, bool) {
+	valuesA, ok := c.parse(a)
+	if !ok {
+		return 0, false
+	}
+
+	valuesB, ok := c.parse(b)
+	if !ok {
+		return 0, false
+	}
+
+	for i := range valuesA {
+		switch {
+		case valuesA[i] < valuesB[i]:
+			return -1, true
+		case valuesA[i] > valuesB[i]:
+			return 1, true
+		}
+	}
+
+	return 0, true
+}
+
+// findVersion the TagFormat and version of tag, versions may have any separators unlike ParseTag.
+func (c *CalVer) findVersion(tag string) (TagFormat, string, bool) {
+	match := c.search.FindStringSubmatch(tag)
+	if match == nil {
+		return TagFormat{}, "", false
+	}
+
+	return TagFormat{Prefix: match[1], Suffix: match[len(match)-1]}, match[2], true
+}
+
+func (c *CalVer) parse(version string) ([]int, bool) {
+	match := c.pattern.FindStringSubmatch(version)
+	if match == nil {
+		return nil, false
+	}
+
+	values := make([]int, len(c.tokens))
+	for i := range c.tokens {
+		value, err := strconv.Atoi(match[i+1])
+		if err != nil || !c.tokens[i].valid(value) {
+			return nil, false
+		}
+
+		values[i] = value
+	}
+
+	return values, true
+}
+
+func (c *CalVer) render(values []int) string {
+	var b strings.Builder
+
+	for i, token := range c.tokens {
+		b.WriteString(c.literals[i])
+		b.WriteString(token.render(values[i]))
+	}
+
+	b.WriteString(c.literals[len(c.literals)-1])
+	return b.String()
+}
+
+func (t calVerToken) isCounter() bool {
+	return t == major || t == minor || t == micro || t == counter
+}
+
+// pattern the digits of the token, 4 for YYYY and 2 for padded tokens.
+func (t calVerToken) pattern() string {
+	switch t {
+	case fullYear:
+		return `\d{4}`
+	case shortYear:
+		return `\d{1,3}`
+	case paddedYear:
+		return `\d{2,3}`
+	case paddedMonth, paddedWeek, paddedDay:
+		return `\d{2}`
+	case month, week, day:
+		return `\d{1,2}`
+	default:
+		return `\d+`
+	}
+}
+
+// valid whether value is in the range of the token, months are 1-12.
+func (t calVerToken) valid(value int) bool {
+	switch t {
+	case month, paddedMonth:
+		return value >= 1 && value <= 12
+	case week, paddedWeek:
+		return value >= 1 && value <= 53
+	case day, paddedDay:
+		return value >= 1 && value <= 31
+	default:
+		return true
+	}
+}
+
+func (t calVerToken) date(now time.Time) int {
+	_, isoWeek := now.ISOWeek()
+
+	switch t {
+	case fullYear:
+		return now.Year()
+	case shortYear, paddedYear:
+		return now.Year() - 2000
+	case month, paddedMonth:
+		return int(now.Month())
+	case week, paddedWeek:
+		return isoWeek
+	case day, paddedDay:
+		return now.Day()
+	default:
+		return 0
+	}
+}
+
+func (t calVerToken) render(value int) string {
+	switch t {
+	case paddedYear, paddedMonth, paddedWeek, paddedDay:
+		return fmt.Sprintf("%02d", value)
+	default:
+		return strconv.Itoa(value)
+	}
+}
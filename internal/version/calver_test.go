@@ -0,0 +1,127 @@
+package version_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NickHackman/releaser/internal/version"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCalVerNext(t *testing.T) {
+	now := func() time.Time { return time.Date(2022, time.February, 3, 0, 0, 0, 0, time.UTC) }
+
+	tests := []struct {
+		name     string
+		format   string
+		change   version.Change
+		latest   string
+		expected string
+	}{
+		{name: "empty", format: "YYYY.0M.MICRO", change: version.IncPatch, latest: "", expected: "2022.02.0"},
+		{name: "same month", format: "YYYY.0M.MICRO", change: version.IncPatch, latest: "2022.02.0", expected: "2022.02.1"},
+		{name: "new month", format: "YYYY.0M.MICRO", change: version.IncPatch, latest: "2022.01.4", expected: "2022.02.0"},
+		{name: "fallback counter", format: "YYYY.0M.MICRO", change: version.IncMajor, latest: "2022.02.1", expected: "2022.02.2"},
+		{name: "not calendar versioned", format: "YYYY.0M.MICRO", change: version.IncPatch, latest: "v1.0.0", expected: "2022.02.0"},
+		{name: "daily empty", format: "YYYY.MM.DD.N", change: version.IncMinor, latest: "", expected: "2022.2.3.1"},
+		{name: "daily same day", format: "YYYY.MM.DD.N", change: version.IncMinor, latest: "2022.2.3.1", expected: "2022.2.3.2"},
+		{name: "daily new day", format: "YYYY.MM.DD.N", change: version.IncMinor, latest: "2022.2.2.7", expected: "2022.2.3.1"},
+		{name: "minor", format: "YY.MINOR.MICRO", change: version.IncMinor, latest: "22.1.3", expected: "22.2.0"},
+		{name: "micro", format: "YY.MINOR.MICRO", change: version.IncPatch, latest: "22.1.3", expected: "22.1.4"},
+		{name: "new year", format: "YY.MINOR.MICRO", change: version.IncPatch, latest: "21.4.3", expected: "22.0.0"},
+		{name: "major kept", format: "MAJOR.YYYY.0M", change: version.IncPatch, latest: "3.2021.12", expected: "3.2022.02"},
+		{name: "major", format: "MAJOR.YYYY.0M", change: version.IncMajor, latest: "3.2022.02", expected: "4.2022.02"},
+		{name: "literal", format: "release-YYYY.0W", change: version.IncPatch, latest: "", expected: "release-2022.05"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			calver, err := version.NewCalVer(test.format, now)
+			assert.NoError(t, err)
+
+			assert.Equal(t, test.expected, calver.Next(test.latest, test.change))
+		})
+	}
+}
+
+func TestCalVerCompare(t *testing.T) {
+	calver, err := version.NewCalVer("YYYY.MM.DD.N", time.Now)
+	assert.NoError(t, err)
+
+	result, ok := calver.Compare("2022.1.31.2", "2022.2.1.1")
+	assert.True(t, ok)
+	assert.Equal(t, -1, result)
+
+	result, ok = calver.Compare("2022.2.1.10", "2022.2.1.9")
+	assert.True(t, ok)
+	assert.Equal(t, 1, result)
+
+	_, ok = calver.Compare("v1.2.3", "2022.2.1.9")
+	assert.False(t, ok)
+
+	assert.True(t, calver.Valid("2022.2.1.9"))
+	assert.False(t, calver.Valid("2022.2.1"))
+}
+
+func TestCalVerValid(t *testing.T) {
+	tests := []struct {
+		name     string
+		format   string
+		version  string
+		expected bool
+	}{
+		{name: "valid", format: "YYYY.0M.MICRO", version: "2022.02.1", expected: true},
+		{name: "semantic version", format: "YYYY.0M.MICRO", version: "1.2.3", expected: false},
+		{name: "4 part semantic version", format: "YYYY.MM.DD.N", version: "1.2.3.4", expected: false},
+		{name: "short year", format: "YYYY.0M.MICRO", version: "22.02.1", expected: false},
+		{name: "unpadded month", format: "YYYY.0M.MICRO", version: "2022.2.1", expected: false},
+		{name: "month out of range", format: "YYYY.MM.MICRO", version: "2022.13.1", expected: false},
+		{name: "zero month", format: "YYYY.0M.MICRO", version: "2022.00.1", expected: false},
+		{name: "day out of range", format: "YYYY.MM.DD.N", version: "2022.2.32.1", expected: false},
+		{name: "too many components", format: "YYYY.0M.MICRO", version: "2022.02.1.1", expected: false},
+		{name: "too few components", format: "YYYY.0M.MICRO", version: "2022.02", expected: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			calver, err := version.NewCalVer(test.format, time.Now)
+			assert.NoError(t, err)
+
+			assert.Equal(t, test.expected, calver.Valid(test.version))
+		})
+	}
+}
+
+func TestNewCalVerInvalid(t *testing.T) {
+	_, err := version.NewCalVer("v1", time.Now)
+	assert.NotNil(t, err)
+}
+
+func TestSchemeFromString(t *testing.T) {
+	tests := []struct {
+		name   string
+		scheme string
+		format string
+		isErr  bool
+	}{
+		{name: "default", scheme: ""},
+		{name: "semver", scheme: "semver"},
+		{name: "calver", scheme: "calver"},
+		{name: "calver format", scheme: "calver", format: "YYYY.MM.DD.N"},
+		{name: "calver invalid format", scheme: "calver", format: "v1", isErr: true},
+		{name: "invalid", scheme: "invalid", isErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			scheme, err := version.SchemeFromString(test.scheme, test.format)
+			if test.isErr {
+				assert.NotNil(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.NotNil(t, scheme)
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package version
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// conventionalHeader matches the first line of a Conventional Commit `type(scope)!: description`.
+var conventionalHeader = regexp.MustCompile(`^(\w+)(\([^)]*\))?(!)?: \S`)
+
+var breakingFooter = regexp.MustCompile(`(?m)^BREAKING[ -]CHANGE: `)
+
+// Infer determines the Change from Conventional Commit messages (https://www.conventionalcommits.org).
+//
+// Returns the Change and the reason it was chosen.
+//
+// feat: add endpoint             -> minor
+// fix: handle nil                -> patch
+// feat!: drop endpoint           -> major
+// BREAKING CHANGE: in the footer -> major
+//
+// Defaults to patch when there are no features or breaking changes.
+func Infer(messages []string) (Change, string) {
+	var breaking, features, fixes []string
+
+	for _, message := range messages {
+		summary := strings.Split(message, "\n")[0]
+
+		match := conventionalHeader.FindStringSubmatch(summary)
+		if match == nil {
+			continue
+		}
+
+		switch {
+		case match[3] == "!" || breakingFooter.MatchString(message):
+			breaking = append(breaking, summary)
+		case strings.EqualFold(match[1], "feat"):
+			features = append(features, summary)
+		case strings.EqualFold(match[1], "fix"):
+			fixes = append(fixes, summary)
+		}
+	}
+
+	switch {
+	case len(breaking) > 0:
+		return IncMajor, reason(breaking, "breaking change")
+	case len(features) > 0:
+		return IncMinor, reason(features, "feature")
+	case len(fixes) > 0:
+		return IncPatch, reason(fixes, "fix")
+	default:
+		return IncPatch, "no features, fixes or breaking changes"
+	}
+}
+
+func reason(summaries []string, kind string) string {
+	if len(summaries) == 1 {
+		return fmt.Sprintf("1 %s: %s", kind, summaries[0])
+	}
+
+	plural := kind + "s"
+	if strings.HasSuffix(kind, "x") {
+		plural = kind + "es"
+	}
+
+	return fmt.Sprintf("%d %s, latest: %s", len(summaries), plural, summaries[0])
+}
@@ -0,0 +1,42 @@
+package version_test
+
+import (
+	"testing"
+
+	"github.com/NickHackman/releaser/internal/version"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInfer(t *testing.T) {
+	tests := []struct {
+		name     string
+		messages []string
+		expected version.Change
+		reason   string
+	}{
+		{name: "empty", messages: nil, expected: version.IncPatch, reason: "no features, fixes or breaking changes"},
+		{name: "not conventional", messages: []string{"update README"}, expected: version.IncPatch, reason: "no features, fixes or breaking changes"},
+		{name: "chore", messages: []string{"chore: bump dependencies"}, expected: version.IncPatch, reason: "no features, fixes or breaking changes"},
+		{name: "fix", messages: []string{"fix: handle nil"}, expected: version.IncPatch, reason: "1 fix: fix: handle nil"},
+		{name: "fixes", messages: []string{"fix: a", "fix(api): b"}, expected: version.IncPatch, reason: "2 fixes, latest: fix: a"},
+		{name: "feat", messages: []string{"fix: handle nil", "feat(ui): add preview"}, expected: version.IncMinor, reason: "1 feature: feat(ui): add preview"},
+		{name: "bang", messages: []string{"feat: a", "refactor!: drop v1"}, expected: version.IncMajor, reason: "1 breaking change: refactor!: drop v1"},
+		{name: "footer", messages: []string{"feat: new config\n\nBREAKING CHANGE: config moved"}, expected: version.IncMajor, reason: "1 breaking change: feat: new config"},
+		{name: "footer in summary", messages: []string{"BREAKING CHANGE: not a footer"}, expected: version.IncPatch, reason: "no features, fixes or breaking changes"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			change, reason := version.Infer(test.messages)
+
+			assert.Equal(t, test.expected, change)
+			assert.Equal(t, test.reason, reason)
+		})
+	}
+}
+
+func TestNewAuto(t *testing.T) {
+	assert.Equal(t, "v2.0.0", version.New("v1.2.3", version.IncAuto, "feat!: drop v1"))
+	assert.Equal(t, "v1.3.0", version.New("v1.2.3", version.IncAuto, "fix: a", "feat: b"))
+	assert.Equal(t, "v1.2.4", version.New("v1.2.3", version.IncAuto, "docs: typo"))
+}
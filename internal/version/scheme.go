@@ -0,0 +1,67 @@
+package version
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/semver"
+)
+
+const (
+	SemVerScheme = "semver"
+	CalVerScheme = "calver"
+
+	defaultCalVerFormat = "YYYY.0M.MICRO"
+)
+
+// Scheme versioning scheme that determines the version after the latest.
+type Scheme interface {
+	// Next version after latest, latest has no prefix or suffix and is empty when there are no previous versions.
+	Next(latest string, change Change, messages ...string) string
+	// Valid whether version is a version of the scheme, version has no prefix or suffix.
+	Valid(version string) bool
+	// Compare versions a and b returning -1, 0 or 1, ok is false when either is not a version of the scheme.
+	Compare(a, b string) (result int, ok bool)
+}
+
+// SchemeFromString creates the Scheme named scheme, format is only used by CalVer and defaults to YYYY.0M.MICRO.
+func SchemeFromString(scheme, format string) (Scheme, error) {
+	switch scheme {
+	case "", SemVerScheme:
+		return SemVer{}, nil
+	case CalVerScheme:
+		if format == "" {
+			format = defaultCalVerFormat
+		}
+
+		return NewCalVer(format, time.Now)
+	default:
+		return nil, fmt.Errorf("invalid version scheme '%s' expected one of '%s', '%s'", scheme, SemVerScheme, CalVerScheme)
+	}
+}
+
+// SemVer Semantic Versioning https://semver.org.
+type SemVer struct{}
+
+func (SemVer) Next(latest string, change Change, messages ...string) string {
+	return next(latest, change, messages...)
+}
+
+func (SemVer) Valid(version string) bool {
+	_, err := semver.NewVersion(version)
+	return err == nil
+}
+
+func (SemVer) Compare(a, b string) (int, bool) {
+	semA, err := semver.NewVersion(a)
+	if err != nil {
+		return 0, false
+	}
+
+	semB, err := semver.NewVersion(b)
+	if err != nil {
+		return 0, false
+	}
+
+	return semA.Compare(semB), true
+}
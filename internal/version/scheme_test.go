@@ -0,0 +1,41 @@
+package version_test
+
+import (
+	"testing"
+
+	"github.com/NickHackman/releaser/internal/version"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSemVerCompare(t *testing.T) {
+	tests := []struct {
+		name     string
+		a        string
+		b        string
+		expected int
+		notOk    bool
+	}{
+		{name: "less", a: "1.2.3", b: "1.10.0", expected: -1},
+		{name: "equal", a: "1.2.3", b: "1.2.3", expected: 0},
+		{name: "prerelease", a: "1.2.3", b: "1.2.3-rc.1", expected: 1},
+		{name: "invalid", a: "nightly", b: "1.2.3", notOk: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, ok := version.SemVer{}.Compare(test.a, test.b)
+			if test.notOk {
+				assert.False(t, ok)
+				return
+			}
+
+			assert.True(t, ok)
+			assert.Equal(t, test.expected, result)
+		})
+	}
+}
+
+func TestSemVerValid(t *testing.T) {
+	assert.True(t, version.SemVer{}.Valid("1.2.3"))
+	assert.False(t, version.SemVer{}.Valid("nightly"))
+}
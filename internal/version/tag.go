@@ -0,0 +1,136 @@
+package version
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+	"text/template"
+)
+
+// tagPattern a dotted version surrounded by a prefix and suffix service-a/v1.2.3-rc.1 or release-2022.01.0.
+var tagPattern = regexp.MustCompile(`^(.*?)(\d+(?:\.\d+)+(?:-[0-9A-Za-z.-]+)?(?:\+[0-9A-Za-z.-]+)?)(.*)$`)
+
+// versionSentinel placeholder for the version when executing a tag template.
+const versionSentinel = "\x00"
+
+// TagFormat the prefix and suffix surrounding the version in a tag.
+type TagFormat struct {
+	Prefix string
+	Suffix string
+}
+
+// TagFormatFromTemplate creates a TagFormat from a Go template where {{ .Version }} is the version service-a/v{{ .Version }}.
+func TagFormatFromTemplate(tmpl string) (TagFormat, error) {
+	t, err := template.New("tag").Parse(tmpl)
+	if err != nil {
+		return TagFormat{}, fmt.Errorf("failed to parse tag template '%s': %v", tmpl, err)
+	}
+
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, map[string]string{"Version": versionSentinel}); err != nil {
+		return TagFormat{}, fmt.Errorf("failed to execute tag template '%s': %v", tmpl, err)
+	}
+
+	parts := strings.Split(buf.String(), versionSentinel)
+	if len(parts) != 2 {
+		return TagFormat{}, fmt.Errorf("invalid tag template '%s' expected {{ .Version }} exactly once", tmpl)
+	}
+
+	return TagFormat{Prefix: parts[0], Suffix: parts[1]}, nil
+}
+
+// ParseTag infers the TagFormat and version of tag service-a/v1.2.3 -> (service-a/v, 1.2.3).
+func ParseTag(tag string) (TagFormat, string, bool) {
+	match := tagPattern.FindStringSubmatch(tag)
+	if match == nil {
+		return TagFormat{}, "", false
+	}
+
+	return TagFormat{Prefix: match[1], Suffix: match[3]}, match[2], true
+}
+
+// versionFinder a Scheme that finds its versions in tags itself, e.g. versions that aren't dotted.
+type versionFinder interface {
+	findVersion(tag string) (TagFormat, string, bool)
+}
+
+// parseTag infers the TagFormat and version of tag using scheme when it finds its versions, otherwise ParseTag.
+func parseTag(scheme Scheme, tag string) (TagFormat, string, bool) {
+	if finder, ok := scheme.(versionFinder); ok {
+		return finder.findVersion(tag)
+	}
+
+	return ParseTag(tag)
+}
+
+// Matches whether tag follows the TagFormat.
+func (f TagFormat) Matches(tag string) bool {
+	return len(tag) > len(f.Prefix)+len(f.Suffix) && strings.HasPrefix(tag, f.Prefix) && strings.HasSuffix(tag, f.Suffix)
+}
+
+// Version the version of tag without the prefix and suffix.
+func (f TagFormat) Version(tag string) string {
+	return strings.TrimSuffix(strings.TrimPrefix(tag, f.Prefix), f.Suffix)
+}
+
+// Tag the tag for version.
+func (f TagFormat) Tag(version string) string {
+	return f.Prefix + version + f.Suffix
+}
+
+// ValidTag whether tag is a version of scheme that follows format, when format is nil any prefix or suffix is accepted.
+func ValidTag(scheme Scheme, format *TagFormat, tag string) bool {
+	if format != nil {
+		return format.Matches(tag) && scheme.Valid(format.Version(tag))
+	}
+
+	_, version, ok := parseTag(scheme, tag)
+	return ok && scheme.Valid(version)
+}
+
+// CompareTags compares the versions of tags a and b ignoring their prefix and suffix, returning -1, 0 or 1.
+// ok is false when either is not a version of scheme.
+func CompareTags(scheme Scheme, a, b string) (result int, ok bool) {
+	_, versionA, ok := parseTag(scheme, a)
+	if !ok {
+		return 0, false
+	}
+
+	_, versionB, ok := parseTag(scheme, b)
+	if !ok {
+		return 0, false
+	}
+
+	return scheme.Compare(versionA, versionB)
+}
+
+// NextTag determines the tag after latest using scheme.
+//
+// When format is nil the prefix and suffix of latest are preserved 1.2.3 -> 1.3.0, service-a/v1.2.3 -> service-a/v1.3.0
+// otherwise latest is only used if it matches format. Semantic versions default to the v prefix.
+func NextTag(scheme Scheme, format *TagFormat, latest string, change Change, messages ...string) string {
+	var version string
+
+	switch {
+	case format != nil:
+		if format.Matches(latest) {
+			version = format.Version(latest)
+		}
+	case latest == "":
+		format = &TagFormat{}
+		if _, ok := scheme.(SemVer); ok {
+			format.Prefix = vPrefix
+		}
+	default:
+		inferred, parsed, ok := parseTag(scheme, latest)
+		if !ok {
+			// Not a version, the scheme determines what to do. Handles situations like "Nightly", "Beta", etc
+			inferred, parsed = TagFormat{}, latest
+		}
+
+		format, version = &inferred, parsed
+	}
+
+	return format.Tag(scheme.Next(version, change, messages...))
+}
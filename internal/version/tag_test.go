@@ -0,0 +1,148 @@
+package version_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NickHackman/releaser/internal/version"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTagFormatFromTemplate(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		expected version.TagFormat
+		isErr    bool
+	}{
+		{name: "bare", template: "{{ .Version }}", expected: version.TagFormat{}},
+		{name: "v prefix", template: "v{{ .Version }}", expected: version.TagFormat{Prefix: "v"}},
+		{name: "monorepo", template: "service-a/v{{ .Version }}", expected: version.TagFormat{Prefix: "service-a/v"}},
+		{name: "suffix", template: "{{ .Version }}-final", expected: version.TagFormat{Suffix: "-final"}},
+		{name: "missing version", template: "v1", isErr: true},
+		{name: "duplicate version", template: "{{ .Version }}{{ .Version }}", isErr: true},
+		{name: "invalid template", template: "{{ .Version", isErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			format, err := version.TagFormatFromTemplate(test.template)
+			if test.isErr {
+				assert.NotNil(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, format)
+		})
+	}
+}
+
+func TestParseTag(t *testing.T) {
+	tests := []struct {
+		name     string
+		tag      string
+		format   version.TagFormat
+		version  string
+		notFound bool
+	}{
+		{name: "bare", tag: "1.2.3", version: "1.2.3"},
+		{name: "v prefix", tag: "v1.2.3", format: version.TagFormat{Prefix: "v"}, version: "1.2.3"},
+		{name: "release prefix", tag: "release-1.2.3", format: version.TagFormat{Prefix: "release-"}, version: "1.2.3"},
+		{name: "monorepo", tag: "service-a2/v1.2.3", format: version.TagFormat{Prefix: "service-a2/v"}, version: "1.2.3"},
+		{name: "prerelease", tag: "v1.2.3-rc.1", format: version.TagFormat{Prefix: "v"}, version: "1.2.3-rc.1"},
+		{name: "calendar", tag: "2022.01.0", version: "2022.01.0"},
+		{name: "nightly", tag: "nightly", notFound: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			format, v, ok := version.ParseTag(test.tag)
+			if test.notFound {
+				assert.False(t, ok)
+				return
+			}
+
+			assert.True(t, ok)
+			assert.Equal(t, test.format, format)
+			assert.Equal(t, test.version, v)
+		})
+	}
+}
+
+func TestNextTag(t *testing.T) {
+	monorepo := &version.TagFormat{Prefix: "service-a/v"}
+
+	tests := []struct {
+		name     string
+		format   *version.TagFormat
+		latest   string
+		expected string
+	}{
+		{name: "bare", latest: "1.2.3", expected: "1.3.0"},
+		{name: "release prefix", latest: "release-1.2.3", expected: "release-1.3.0"},
+		{name: "monorepo", latest: "service-a/v1.2.3", expected: "service-a/v1.3.0"},
+		{name: "format", format: monorepo, latest: "service-a/v1.2.3", expected: "service-a/v1.3.0"},
+		{name: "format empty", format: monorepo, latest: "", expected: "service-a/v0.1.0"},
+		{name: "format mismatch", format: monorepo, latest: "service-b/v4.0.0", expected: "service-a/v0.1.0"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, version.NextTag(version.SemVer{}, test.format, test.latest, version.IncMinor))
+		})
+	}
+}
+
+func TestCalVerTags(t *testing.T) {
+	now := func() time.Time { return time.Date(2022, time.February, 3, 0, 0, 0, 0, time.UTC) }
+
+	tests := []struct {
+		format   string
+		latest   string
+		lower    string
+		expected string
+	}{
+		{format: "YYYY-0M-MICRO", latest: "v2022-02-0", lower: "v2022-01-4", expected: "v2022-02-1"},
+		{format: "YY.0M_MICRO", latest: "release-22.02_0", lower: "release-22.01_4", expected: "release-22.02_1"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.format, func(t *testing.T) {
+			calver, err := version.NewCalVer(test.format, now)
+			assert.NoError(t, err)
+
+			assert.True(t, version.ValidTag(calver, nil, test.latest))
+			assert.True(t, version.ValidTag(calver, nil, test.lower))
+			assert.False(t, version.ValidTag(calver, nil, "v1.2.3"))
+
+			result, ok := version.CompareTags(calver, test.latest, test.lower)
+			assert.True(t, ok)
+			assert.Equal(t, 1, result)
+
+			next := version.NextTag(calver, nil, test.latest, version.IncPatch)
+			assert.Equal(t, test.expected, next)
+			assert.True(t, version.ValidTag(calver, nil, next))
+		})
+	}
+}
+
+func TestValidTag(t *testing.T) {
+	monorepo := &version.TagFormat{Prefix: "service-a/v"}
+
+	assert.True(t, version.ValidTag(version.SemVer{}, nil, "v1.2.3"))
+	assert.True(t, version.ValidTag(version.SemVer{}, nil, "release-1.2.3"))
+	assert.False(t, version.ValidTag(version.SemVer{}, nil, "nightly"))
+	assert.True(t, version.ValidTag(version.SemVer{}, monorepo, "service-a/v1.2.3"))
+	assert.False(t, version.ValidTag(version.SemVer{}, monorepo, "service-b/v1.2.3"))
+	assert.False(t, version.ValidTag(version.SemVer{}, monorepo, "service-a/vnightly"))
+}
+
+func TestCompareTags(t *testing.T) {
+	result, ok := version.CompareTags(version.SemVer{}, "v1.2.3", "release-1.3.0")
+	assert.True(t, ok)
+	assert.Equal(t, -1, result)
+
+	_, ok = version.CompareTags(version.SemVer{}, "v1.2.3", "nightly")
+	assert.False(t, ok)
+}
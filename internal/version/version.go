@@ -2,6 +2,9 @@ package version
 
 import (
 	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
 
 	"github.com/Masterminds/semver"
 )
@@ -12,25 +15,95 @@ const (
 	IncMajor Change = "major"
 	IncMinor Change = "minor"
 	IncPatch Change = "patch"
+	// IncAuto infers the change from Conventional Commits.
+	IncAuto Change = "auto"
+	// Promote drops the pre-release of the latest version 1.5.0-rc.3 -> 1.5.0, a final version is a patch 1.5.0 -> 1.5.1.
+	Promote Change = "promote"
 )
 
 const (
-	defaultVersion = "v0.1.0"
-	vPrefix        = "v"
+	defaultVersion   = "0.1.0"
+	vPrefix          = "v"
+	prereleasePrefix = "prerelease:"
 )
 
+var prereleaseIdentifier = regexp.MustCompile(`^[0-9A-Za-z-]+$`)
+
+// PreRelease creates the Change to start or continue the pre-release line identifier (alpha, beta, rc, etc).
+func PreRelease(identifier string) Change {
+	return Change(prereleasePrefix + identifier)
+}
+
+// PreRelease the pre-release identifier of the Change, if the Change is a pre-release.
+func (c Change) PreRelease() (string, bool) {
+	if !strings.HasPrefix(string(c), prereleasePrefix) {
+		return "", false
+	}
+
+	return strings.TrimPrefix(string(c), prereleasePrefix), true
+}
+
 func ChangeFromString(change string) (Change, error) {
 	c := Change(change)
 
-	if c != IncMajor && c != IncMinor && c != IncPatch {
-		return "", fmt.Errorf("invalid version '%s' expected one of 'major', 'minor', 'patch'", change)
+	if identifier, ok := c.PreRelease(); ok {
+		if !prereleaseIdentifier.MatchString(identifier) {
+			return "", fmt.Errorf("invalid pre-release identifier '%s' expected alphanumerics and hyphens", identifier)
+		}
+
+		return c, nil
+	}
+
+	if c != IncMajor && c != IncMinor && c != IncPatch && c != IncAuto && c != Promote {
+		return "", fmt.Errorf("invalid version '%s' expected one of 'major', 'minor', 'patch', 'auto', 'promote', 'prerelease:<identifier>'", change)
 	}
 
 	return c, nil
 }
 
-func New(latest string, change Change) string {
+// IsPreRelease whether tag is a semantic version with a pre-release v1.5.0-rc.1, when format is nil any prefix or suffix
+// is accepted otherwise the prefix and suffix of format aren't part of the version.
+func IsPreRelease(scheme Scheme, format *TagFormat, tag string) bool {
+	var version string
+
+	if format != nil {
+		if !format.Matches(tag) {
+			return false
+		}
+
+		version = format.Version(tag)
+	} else {
+		var ok bool
+		if _, version, ok = parseTag(scheme, tag); !ok {
+			return false
+		}
+	}
+
+	sem, err := semver.NewVersion(version)
+	if err != nil {
+		return false
+	}
+
+	return sem.Prerelease() != ""
+}
+
+// New determines the tag after latest using Semantic Versioning preserving the prefix and suffix of latest,
+// when change is IncAuto the change is inferred from the commit messages.
+func New(latest string, change Change, messages ...string) string {
+	return NextTag(SemVer{}, nil, latest, change, messages...)
+}
+
+// next the semantic version after latest, where latest has no prefix or suffix.
+func next(latest string, change Change, messages ...string) string {
+	if change == IncAuto {
+		change, _ = Infer(messages)
+	}
+
 	if latest == "" {
+		if identifier, ok := change.PreRelease(); ok {
+			return defaultVersion + "-" + identifier + ".1"
+		}
+
 		return defaultVersion
 	}
 
@@ -40,18 +113,67 @@ func New(latest string, change Change) string {
 		return latest
 	}
 
+	// A pre-release of the change is finalized 2.0.0-rc.1 -> 2.0.0, IncPatch drops the pre-release
+	finalizes := sem.Prerelease() != "" && sem.Patch() == 0
+
 	var new semver.Version
 	switch change {
 	case IncMajor:
-		new = sem.IncMajor()
+		if finalizes && sem.Minor() == 0 {
+			new = sem.IncPatch()
+		} else {
+			new = sem.IncMajor()
+		}
 	case IncMinor:
-		new = sem.IncMinor()
-	case IncPatch:
+		if finalizes {
+			new = sem.IncPatch()
+		} else {
+			new = sem.IncMinor()
+		}
+	case IncPatch, Promote:
+		// Without a pre-release to promote, promote is a patch
 		new = sem.IncPatch()
 	default:
-		// Impossible
-		panic("provided change doesn't exist - this is a bug")
+		identifier, ok := change.PreRelease()
+		if !ok {
+			// Impossible
+			panic("provided change doesn't exist - this is a bug")
+		}
+
+		new = nextPreRelease(sem, identifier)
+	}
+
+	return new.String()
+}
+
+// nextPreRelease continues the pre-release line of identifier 1.5.0-rc.1 -> 1.5.0-rc.2, otherwise starts a new line
+// 1.5.0-alpha.2 -> 1.5.0-rc.1 and 1.4.0 -> 1.5.0-rc.1.
+func nextPreRelease(sem *semver.Version, identifier string) semver.Version {
+	core := *sem
+	if sem.Prerelease() == "" {
+		core = sem.IncMinor()
+	}
+
+	number := 1
+
+	if current := sem.Prerelease(); current == identifier || strings.HasPrefix(current, identifier+".") {
+		// rc -> rc.1, rc.1 -> rc.2
+		if n, err := strconv.Atoi(strings.TrimPrefix(current, identifier+".")); err == nil {
+			number = n + 1
+		}
+	}
+
+	new, err := core.SetMetadata("")
+	if err != nil {
+		// Impossible, empty metadata is always valid
+		panic(err)
+	}
+
+	new, err = new.SetPrerelease(fmt.Sprintf("%s.%d", identifier, number))
+	if err != nil {
+		// Impossible, identifier is validated by ChangeFromString
+		panic(err)
 	}
 
-	return vPrefix + new.String()
+	return new
 }
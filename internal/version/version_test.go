@@ -20,6 +20,20 @@ func TestNew(t *testing.T) {
 		{name: "major", change: version.IncMajor, latest: "v1.0.0", expected: "v2.0.0"},
 		{name: "beta", change: version.IncMajor, latest: "beta", expected: "beta"},
 		{name: "nightly", change: version.IncMajor, latest: "nightly", expected: "nightly"},
+		{name: "no prefix", change: version.IncMinor, latest: "1.0.0", expected: "1.1.0"},
+		{name: "monorepo prefix", change: version.IncMinor, latest: "service-a/v1.0.0", expected: "service-a/v1.1.0"},
+		{name: "empty prerelease", change: version.PreRelease("rc"), latest: "", expected: "v0.1.0-rc.1"},
+		{name: "start prerelease", change: version.PreRelease("rc"), latest: "v1.4.0", expected: "v1.5.0-rc.1"},
+		{name: "continue prerelease", change: version.PreRelease("rc"), latest: "v1.5.0-rc.1", expected: "v1.5.0-rc.2"},
+		{name: "continue unnumbered prerelease", change: version.PreRelease("rc"), latest: "v1.5.0-rc", expected: "v1.5.0-rc.1"},
+		{name: "switch prerelease", change: version.PreRelease("beta"), latest: "v1.5.0-alpha.3", expected: "v1.5.0-beta.1"},
+		{name: "promote", change: version.Promote, latest: "v1.5.0-rc.3", expected: "v1.5.0"},
+		{name: "promote release", change: version.Promote, latest: "v1.5.0", expected: "v1.5.1"},
+		{name: "patch prerelease", change: version.IncPatch, latest: "v1.5.0-rc.3", expected: "v1.5.0"},
+		{name: "minor prerelease", change: version.IncMinor, latest: "v1.5.0-rc.3", expected: "v1.5.0"},
+		{name: "minor patch prerelease", change: version.IncMinor, latest: "v1.5.1-rc.1", expected: "v1.6.0"},
+		{name: "major prerelease", change: version.IncMajor, latest: "v2.0.0-rc.1", expected: "v2.0.0"},
+		{name: "major minor prerelease", change: version.IncMajor, latest: "v1.5.0-rc.3", expected: "v2.0.0"},
 	}
 
 	for _, test := range tests {
@@ -40,6 +54,11 @@ func TestChangeFromString(t *testing.T) {
 		{name: "patch", input: "patch", expected: version.IncPatch},
 		{name: "minor", input: "minor", expected: version.IncMinor},
 		{name: "major", input: "major", expected: version.IncMajor},
+		{name: "auto", input: "auto", expected: version.IncAuto},
+		{name: "promote", input: "promote", expected: version.Promote},
+		{name: "prerelease", input: "prerelease:rc", expected: version.PreRelease("rc")},
+		{name: "empty prerelease", input: "prerelease:", isErr: true},
+		{name: "invalid prerelease", input: "prerelease:rc.1", isErr: true},
 	}
 
 	for _, test := range tests {
@@ -55,3 +74,28 @@ func TestChangeFromString(t *testing.T) {
 		})
 	}
 }
+
+func TestIsPreRelease(t *testing.T) {
+	linux := &version.TagFormat{Prefix: "v", Suffix: "-linux"}
+
+	tests := []struct {
+		name     string
+		format   *version.TagFormat
+		tag      string
+		expected bool
+	}{
+		{name: "prerelease", tag: "v1.5.0-rc.1", expected: true},
+		{name: "monorepo prefix", tag: "service-a/v1.5.0-rc.1", expected: true},
+		{name: "release", tag: "v1.5.0", expected: false},
+		{name: "not a version", tag: "nightly", expected: false},
+		{name: "suffixed release", format: linux, tag: "v1.3.0-linux", expected: false},
+		{name: "suffixed prerelease", format: linux, tag: "v1.3.0-rc.1-linux", expected: true},
+		{name: "not the format", format: linux, tag: "v1.3.0-rc.1", expected: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, version.IsPreRelease(version.SemVer{}, test.format, test.tag))
+		})
+	}
+}